@@ -1,127 +1,149 @@
 package animators
 
 import (
-	"fmt"
 	"io"
+	"strings"
 
 	"github.com/influx6/govfx"
 )
 
 //==============================================================================
 
-// Width provides animation sequencing for width properties, it uses flat integers
-// values and pixels.
-type Width struct {
-	Target int          `govfx:"value"`
-	Easing string       `govfx:"easing"`
-	Easer  govfx.Easing `govfx:"Easer"`
+// lengthProperty provides the shared state of the length animators, which
+// animate a length property from the current length of the element towards
+// their target length. Both lengths are resolved into pixels using the context
+// of the element, while the current length is written out in the unit of the
+// target.
+type lengthProperty struct {
+	prop  string
+	unit  string
+	ctx   govfx.LengthContext
+	tween govfx.Tween
+}
 
-	current float64
-	accum   float64
+// init sets the starting length from the current value of the property of the
+// element and parses the target length, which can be relative to the starting
+// length (eg "+=20px" or "*=2"), where a unitless or calc() target is written
+// out in pixels.
+func (l *lengthProperty) init(elem govfx.Elemental, prop string, target string, easer govfx.Easing, easing string) error {
+	easer, err := easerOf(easer, easing)
+	if err != nil {
+		return err
+	}
 
-	elem govfx.Elemental
+	l.prop = prop
+	l.unit = "px"
+	l.ctx = govfx.ContextOf(elem, prop)
 
-	ended bool
-}
+	var from float64
 
-// Init initializes the width property with the provided element for animation.
-func (w *Width) Init(elem govfx.Elemental) {
-	w.elem = elem
-
-	if w.Easer == nil {
-		w.Easer = govfx.GetEasing(w.Easing)
+	if value, _, ok := elem.Read(prop, ""); ok {
+		if length, err := govfx.ParseLength(value); err == nil {
+			from = length.Pixels(l.ctx)
+		}
 	}
 
-	if ws, _, ok := elem.ReadInt("width", ""); ok {
-		w.current = float64(ws)
+	to := from
+	op, target := govfx.SplitOperator(target)
+
+	if length, err := govfx.ParseLength(target); err == nil {
+		to = govfx.ApplyOperator(op, from, length.Pixels(l.ctx))
+
+		if unit, known := govfx.Unit(length.Unit); known && !length.IsCalc() {
+			l.unit = unit
+		}
 	}
+
+	l.tween = govfx.NewTween(from, to, easer)
+	return nil
 }
 
-// Update contains the update operations for the width property.
-// All calculations are handled here, it recieves the delta value to
-// allow
-func (w *Width) Update(delta float64, timeline float64) {
-	cu := int(w.current)
+// Seek sets the length for the timeline progress, without a previous length
+// to blend from.
+func (l *lengthProperty) Seek(timeline float64) {
+	l.tween.Set(timeline)
+}
+
+// Blend blends the length between its last fixed updates.
+func (l *lengthProperty) Blend(interpolate float64) {
+	l.tween.Blend(interpolate)
+}
 
-	easer := w.Easer.Ease(timeline)
+// declare emits the current length of the property in the unit of the target.
+func (l *lengthProperty) declare(decls *govfx.Declarations) {
+	length := govfx.FromPixels(l.tween.Value(), l.unit, l.ctx)
+	decl := govfx.Declaration{Property: l.prop, Value: length.String()}
 
-	if cu < w.Target {
-		w.current += (w.current * delta * easer) + 5
-	} else {
-		w.current = float64(w.Target)
-		w.ended = true
+	if !length.IsCalc() {
+		decl.Value = strings.TrimSuffix(decl.Value, length.Unit)
+		decl.Unit = length.Unit
 	}
+
+	decls.Set(decl)
 }
 
-// Blend takes the last value to which allows us to correct the
-// rendered position of our update.
-func (w *Width) Blend(delta float64) {
-	if !w.ended {
-		w.current += delta
-	}
+//==============================================================================
+
+// Width provides animation sequencing for width properties, its target can be
+// any css length (eg 200, "50%", "2.5rem" or "calc(100% - 20px)").
+type Width struct {
+	Target string       `govfx:"value"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	lengthProperty
+}
+
+// Init initializes the width property with the provided element for animation.
+func (w *Width) Init(elem govfx.Elemental) error {
+	return w.init(elem, "width", w.Target, w.Easer, w.Easing)
+}
+
+// Update contains the update operations for the width property.
+func (w *Width) Update(delta float64, timeline float64) {
+	w.tween.Update(timeline)
+}
+
+// Declare emits the current width as a declaration.
+func (w *Width) Declare(decls *govfx.Declarations) {
+	w.declare(decls)
 }
 
 // CSS writes the css output to the supplied writer
 func (w *Width) CSS(wc io.Writer) {
-	wc.Write([]byte(fmt.Sprintf("width: %d%s", int(w.current), "px")))
+	govfx.DeclarationsOf(w).CSS(wc)
 }
 
 //==============================================================================
 
-// Height provides animation sequencing for Height properties.
+// Height provides animation sequencing for Height properties, its target can
+// be any css length (eg 200, "50%", "30vh" or "calc(100% - 20px)").
 type Height struct {
-	Target int          `govfx:"value"`
+	Target string       `govfx:"value"`
 	Easing string       `govfx:"easing"`
 	Easer  govfx.Easing `govfx:"easer"`
 
-	current float64
-	accum   float64
-
-	elem govfx.Elemental
-
-	ended bool
+	lengthProperty
 }
 
-// Init initializes the width property with the provided element for animation.
-func (h *Height) Init(elem govfx.Elemental) {
-	h.elem = elem
-
-	if h.Easer == nil {
-		h.Easer = govfx.GetEasing(h.Easing)
-	}
-
-	if ws, _, ok := elem.ReadInt("width", ""); ok {
-		h.current = float64(ws)
-	}
+// Init initializes the height property with the provided element for animation.
+func (h *Height) Init(elem govfx.Elemental) error {
+	return h.init(elem, "height", h.Target, h.Easer, h.Easing)
 }
 
-// Update contains the update operations for the width property.
-// All calculations are handled here, it recieves the delta value to
-// allow
+// Update contains the update operations for the height property.
 func (h *Height) Update(delta float64, timeline float64) {
-	cu := int(h.current)
-
-	easer := h.Easer.Ease(timeline)
-
-	if cu < h.Target {
-		h.current += (h.current * delta * easer) + 5
-	} else {
-		h.current = float64(h.Target)
-		h.ended = true
-	}
+	h.tween.Update(timeline)
 }
 
-// Blend takes the last value to which allows us to correct the
-// rendered position of our update.
-func (h *Height) Blend(delta float64) {
-	if !h.ended {
-		h.current += delta
-	}
+// Declare emits the current height as a declaration.
+func (h *Height) Declare(decls *govfx.Declarations) {
+	h.declare(decls)
 }
 
 // CSS writes the css output to the supplied writer
 func (h *Height) CSS(wc io.Writer) {
-	wc.Write([]byte(fmt.Sprintf("height: %d%s", int(h.current), "px")))
+	govfx.DeclarationsOf(h).CSS(wc)
 }
 
 //==============================================================================
@@ -1,10 +1,11 @@
 package animators
 
 import (
-	"fmt"
 	"io"
+	"strings"
 
 	"github.com/influx6/govfx"
+	"github.com/influx6/govfx/colors"
 )
 
 //==============================================================================
@@ -15,123 +16,279 @@ var Colors ColorTransistion
 // ColorTransistion defines a struct for defining color functions.
 type ColorTransistion struct{}
 
-// Interpolate interpolates the current color towards the base color using the
-// delta and timeline values and the easing provider.
-func (ColorTransistion) Interpolate(easing govfx.Easing, base, current ColorValue, delta float64, timeline float64) ColorValue {
-	var newcolor ColorValue
+// Interpolate returns the color at the timeline progress from the from color
+// towards the to color in the provided color space, using the easing provider.
+func (ColorTransistion) Interpolate(easing govfx.Easing, from, to colors.Color, space colors.Space, delta float64, timeline float64) colors.Color {
+	return Colors.Blend(from, to, space, easing.Ease(timeline))
+}
 
-	return newcolor
+// Blend returns the mix of the two colors in the provided color space by the
+// giving amount between 0 and 1.
+func (ColorTransistion) Blend(from, to colors.Color, space colors.Space, amount float64) colors.Color {
+	return colors.Mix(from, to, amount, space)
 }
 
-// Blend returns a new ColorValue with the giving blend function.
-func (ColorTransistion) Blend(easing govfx.Easing, current ColorValue, blend float64) ColorValue {
-	var newcolor ColorValue
+//==============================================================================
 
-	return newcolor
+// colorProperty provides the shared state of the color animators, which
+// animate a color property from the current color of the element towards
+// their target color.
+type colorProperty struct {
+	prop  string
+	space colors.Space
+	easer govfx.Easing
+
+	from     colors.Color
+	to       colors.Color
+	previous colors.Color
+	current  colors.Color
+	value    colors.Color
 }
 
-//==============================================================================
+// init sets the starting color from the current value of the property of the
+// element and parses the target color and the color space used to interpolate,
+// where a unknown space uses sRGB.
+func (c *colorProperty) init(elem govfx.Elemental, prop string, target string, space string, easer govfx.Easing, easing string) error {
+	easer, err := easerOf(easer, easing)
+	if err != nil {
+		return err
+	}
+
+	c.prop = prop
+	c.space, _ = colors.ParseSpace(space)
+	c.easer = easer
+
+	c.from = readColor(elem, prop)
+	c.previous = c.from
+	c.current = c.from
+	c.value = c.from
+
+	if color, err := colors.Parse(target); err == nil {
+		c.to = color
+	} else {
+		c.to = c.from
+	}
 
-// ColorValue defines a struct which represents a color value set.
-type ColorValue struct {
-	red   int
-	green int
-	blue  int
-	alpah float64
+	return nil
 }
 
-// RGBA writes out the color values in RGBA format.
-func (c ColorValue) RGBA() string {
-	return fmt.Sprintf("rgba(%d,%d,%d,%.2f)", c.red, c.green, c.blue, c.alpah)
+// update sets the current color for the timeline progress, keeping the color
+// of the previous update for blending until the timeline reaches its end.
+func (c *colorProperty) update(delta float64, timeline float64) {
+	c.previous = c.current
+	c.current = Colors.Interpolate(c.easer, c.from, c.to, c.space, delta, timeline)
+	c.value = c.current
+
+	if timeline >= 1 {
+		c.previous = c.current
+	}
 }
 
-// RGB writes out the color values in RGB format.
-func (c ColorValue) RGB() string {
-	return fmt.Sprintf("rgba(%d,%d,%d,1)", c.red, c.green, c.blue)
+// Seek sets the color for the timeline progress, without a previous color to
+// blend from.
+func (c *colorProperty) Seek(timeline float64) {
+	c.current = Colors.Interpolate(c.easer, c.from, c.to, c.space, 0, timeline)
+	c.previous = c.current
+	c.value = c.current
 }
 
-// Color provides a animator for sequencing color animations.
-type Color struct {
-	Alpha  bool   `govfx:"alpha"`
-	Color  string `govfx:"color"`
-	Easing string `govfx:"easing"`
+// Blend blends the color between its last fixed updates in the color space of
+// the property.
+func (c *colorProperty) Blend(interpolate float64) {
+	c.value = Colors.Blend(c.previous, c.current, c.space, interpolate)
+}
 
-	color ColorValue
-	base  ColorValue
+// declare emits the current color of the property as a declaration, always in
+// RGBA format when alpha is true.
+func (c *colorProperty) declare(decls *govfx.Declarations, alpha bool) {
+	value := c.value.String()
+	if alpha {
+		value = c.value.RGBA()
+	}
 
-	elem govfx.Elemental
+	decls.Set(govfx.Declaration{Property: c.prop, Value: value})
 }
 
-// Init initializes the property for execution.
-func (t *Color) Init(elem govfx.Elemental) {
-	t.elem = elem
+// readColor returns the color of the property of the element, resolving
+// currentcolor to the color property and using the first color of properties
+// with multiple colors (eg border-color).
+func readColor(elem govfx.Elemental, prop string) colors.Color {
+	value, _, ok := elem.Read(prop, "")
+	if !ok {
+		return colors.Color{}
+	}
+
+	value = firstColor(value)
+
+	if strings.EqualFold(value, "currentcolor") {
+		if prop == "color" {
+			return colors.Color{A: 1}
+		}
 
-	rw, gw, bw, aw := govfx.ParseRGB(t.Color)
-	t.color = ColorValue{red: rw, green: gw, blue: bw, alpah: aw}
+		return readColor(elem, "color")
+	}
+
+	color, err := colors.Parse(value)
+	if err != nil {
+		return colors.Color{}
+	}
+
+	return color
+}
 
-	if color, _, ok := elem.Read("color", ""); ok {
-		r, g, b, a := govfx.ParseRGB(color)
-		t.color = ColorValue{red: r, green: g, blue: b, alpah: a}
+// firstColor returns the first color of a value holding a list of colors.
+func firstColor(value string) string {
+	value = strings.TrimSpace(value)
+
+	if paren := strings.Index(value, "("); paren >= 0 {
+		if space := strings.IndexAny(value, " \t"); space < 0 || space > paren {
+			if end := strings.Index(value, ")"); end > 0 {
+				return value[:end+1]
+			}
+		}
+	}
+
+	if fields := strings.Fields(value); len(fields) > 0 {
+		return fields[0]
 	}
+
+	return value
+}
+
+//==============================================================================
+
+// Color provides a animator for sequencing color animations.
+type Color struct {
+	Alpha  bool         `govfx:"alpha"`
+	Color  string       `govfx:"color"`
+	Space  string       `govfx:"space"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	colorProperty
+}
+
+// Init initializes the property for execution.
+func (t *Color) Init(elem govfx.Elemental) error {
+	return t.init(elem, "color", t.Color, t.Space, t.Easer, t.Easing)
 }
 
 // Update updates the property details.
 func (t *Color) Update(delta float64, timeline float64) {
-	easing := govfx.GetEasing(t.Easing)
-	t.color = Colors.Interpolate(easing, t.base, t.color, delta, timeline)
+	t.update(delta, timeline)
 }
 
-// Blend adjust the property details to match appropriate state.
-func (t *Color) Blend(interpolation float64) {
-	easing := govfx.GetEasing(t.Easing)
-	t.color = Colors.Blend(easing, t.color, interpolation)
+// Declare emits the current color as a declaration.
+func (t *Color) Declare(decls *govfx.Declarations) {
+	t.declare(decls, t.Alpha)
 }
 
 // CSS writes out the current state of the property in css format to the provided
 // writer.
 func (t *Color) CSS(owner io.Writer) {
-	if t.Alpha {
-		owner.Write([]byte("color:" + t.color.RGBA()))
-		return
-	}
-
-	owner.Write([]byte("color:" + t.color.RGB()))
+	govfx.DeclarationsOf(t).CSS(owner)
 }
 
 //==============================================================================
 
 // BackgroundColor provides a animator for sequencing background color animations.
 type BackgroundColor struct {
-	Alpha  bool   `govfx:"alpha"`
-	Color  string `govfx:"color"`
-	Easing string `govfx:"easing"`
+	Alpha  bool         `govfx:"alpha"`
+	Color  string       `govfx:"color"`
+	Space  string       `govfx:"space"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
 
-	color ColorValue
-	base  ColorValue
+	colorProperty
+}
 
-	elem  govfx.Elemental
-	easer govfx.Easing
+// Init initializes the property for execution.
+func (t *BackgroundColor) Init(elem govfx.Elemental) error {
+	return t.init(elem, "background-color", t.Color, t.Space, t.Easer, t.Easing)
 }
 
 // Update updates the property details.
 func (t *BackgroundColor) Update(delta float64, timeline float64) {
-	easing := govfx.GetEasing(t.Easing)
-	t.color = Colors.Interpolate(easing, t.base, t.color, delta, timeline)
+	t.update(delta, timeline)
 }
 
-// Blend adjust the property details to match appropriate state.
-func (t *BackgroundColor) Blend(interpolation float64) {
-	easing := govfx.GetEasing(t.Easing)
-	t.color = Colors.Blend(easing, t.color, interpolation)
+// Declare emits the current color as a declaration.
+func (t *BackgroundColor) Declare(decls *govfx.Declarations) {
+	t.declare(decls, t.Alpha)
 }
 
 // CSS writes out the current state of the property in css format to the provided
 // writer.
 func (t *BackgroundColor) CSS(owner io.Writer) {
-	if t.Alpha {
-		owner.Write([]byte("background-color: " + t.color.RGBA()))
-		return
-	}
+	govfx.DeclarationsOf(t).CSS(owner)
+}
+
+//==============================================================================
+
+// BorderColor provides a animator for sequencing border color animations.
+type BorderColor struct {
+	Alpha  bool         `govfx:"alpha"`
+	Color  string       `govfx:"color"`
+	Space  string       `govfx:"space"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	colorProperty
+}
+
+// Init initializes the property for execution.
+func (t *BorderColor) Init(elem govfx.Elemental) error {
+	return t.init(elem, "border-color", t.Color, t.Space, t.Easer, t.Easing)
+}
+
+// Update updates the property details.
+func (t *BorderColor) Update(delta float64, timeline float64) {
+	t.update(delta, timeline)
+}
+
+// Declare emits the current color as a declaration.
+func (t *BorderColor) Declare(decls *govfx.Declarations) {
+	t.declare(decls, t.Alpha)
+}
+
+// CSS writes out the current state of the property in css format to the provided
+// writer.
+func (t *BorderColor) CSS(owner io.Writer) {
+	govfx.DeclarationsOf(t).CSS(owner)
+}
+
+//==============================================================================
+
+// OutlineColor provides a animator for sequencing outline color animations.
+type OutlineColor struct {
+	Alpha  bool         `govfx:"alpha"`
+	Color  string       `govfx:"color"`
+	Space  string       `govfx:"space"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	colorProperty
+}
+
+// Init initializes the property for execution.
+func (t *OutlineColor) Init(elem govfx.Elemental) error {
+	return t.init(elem, "outline-color", t.Color, t.Space, t.Easer, t.Easing)
+}
+
+// Update updates the property details.
+func (t *OutlineColor) Update(delta float64, timeline float64) {
+	t.update(delta, timeline)
+}
+
+// Declare emits the current color as a declaration.
+func (t *OutlineColor) Declare(decls *govfx.Declarations) {
+	t.declare(decls, t.Alpha)
+}
 
-	owner.Write([]byte("background-color: " + t.color.RGB()))
+// CSS writes out the current state of the property in css format to the provided
+// writer.
+func (t *OutlineColor) CSS(owner io.Writer) {
+	govfx.DeclarationsOf(t).CSS(owner)
 }
+
+//==============================================================================
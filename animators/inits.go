@@ -1,6 +1,10 @@
 package animators
 
-import "github.com/influx6/govfx"
+import (
+	"math"
+
+	"github.com/influx6/govfx"
+)
 
 // init registers all the available animators, so users can take advantage of
 // the new initialization API.
@@ -8,17 +12,37 @@ func init() {
 
 	govfx.RegisterSequence("height", Height{})
 	govfx.RegisterSequence("width", Width{})
-	// govfx.RegisterSequence("translate-x", TranslateX{})
-	// govfx.RegisterSequence("translate-y", TranslateY{})
-	// govfx.RegisterSequence("scale-x", ScaleX{})
-	// govfx.RegisterSequence("scale-y", ScaleY{})
-	// govfx.RegisterSequence("skew-x", SkewX{})
-	// govfx.RegisterSequence("skew-y", SkewY{})
-	// govfx.RegisterSequence("rotate", Rotate{})
-	// govfx.RegisterSequence("rotate-x", RotateX{})
-	// govfx.RegisterSequence("rotate-y", RotateY{})
-	// govfx.RegisterSequence("perspective", Perspective{})
-	//
-	// govfx.RegisterSequence("color", Color{})
-	// govfx.RegisterSequence("background-color", BackgroundColor{})
+	govfx.RegisterSequence("translate-x", TranslateX{})
+	govfx.RegisterSequence("translate-y", TranslateY{})
+	govfx.RegisterSequence("scale-x", ScaleX{})
+	govfx.RegisterSequence("scale-y", ScaleY{})
+	govfx.RegisterSequence("skew-x", SkewX{})
+	govfx.RegisterSequence("skew-y", SkewY{})
+	govfx.RegisterSequence("rotate", Rotate{})
+	govfx.RegisterSequence("rotate-x", RotateX{})
+	govfx.RegisterSequence("rotate-y", RotateY{})
+	govfx.RegisterSequence("perspective", Perspective{})
+
+	govfx.RegisterSequence("color", Color{})
+	govfx.RegisterSequence("background-color", BackgroundColor{})
+	govfx.RegisterSequence("border-color", BorderColor{})
+	govfx.RegisterSequence("outline-color", OutlineColor{})
+
+	govfx.RegisterSequence("opacity", NewProperty("opacity", "").Clamp(0, 1))
+	govfx.RegisterSequence("top", NewProperty("top", "px"))
+	govfx.RegisterSequence("left", NewProperty("left", "px"))
+	govfx.RegisterSequence("right", NewProperty("right", "px"))
+	govfx.RegisterSequence("bottom", NewProperty("bottom", "px"))
+	govfx.RegisterSequence("margin-top", NewProperty("margin-top", "px"))
+	govfx.RegisterSequence("margin-left", NewProperty("margin-left", "px"))
+	govfx.RegisterSequence("margin-right", NewProperty("margin-right", "px"))
+	govfx.RegisterSequence("margin-bottom", NewProperty("margin-bottom", "px"))
+	govfx.RegisterSequence("padding-top", NewProperty("padding-top", "px").Clamp(0, math.Inf(1)))
+	govfx.RegisterSequence("padding-left", NewProperty("padding-left", "px").Clamp(0, math.Inf(1)))
+	govfx.RegisterSequence("padding-right", NewProperty("padding-right", "px").Clamp(0, math.Inf(1)))
+	govfx.RegisterSequence("padding-bottom", NewProperty("padding-bottom", "px").Clamp(0, math.Inf(1)))
+	govfx.RegisterSequence("font-size", NewProperty("font-size", "px").Clamp(0, math.Inf(1)))
+	govfx.RegisterSequence("letter-spacing", NewProperty("letter-spacing", "px"))
+	govfx.RegisterSequence("border-width", NewProperty("border-width", "px").Clamp(0, math.Inf(1)))
+	govfx.RegisterSequence("border-radius", NewProperty("border-radius", "px").Clamp(0, math.Inf(1)))
 }
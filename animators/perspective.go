@@ -1,83 +1,46 @@
 package animators
 
+import (
+	"io"
+
+	"github.com/influx6/govfx"
+)
+
 //==============================================================================
 
-// // Perspective defines a sequence for animating css perspective x-axes properties.
-// type Perspective struct {
-// 	Value  int    `govfx:"value"`
-// 	Easing string `govfx:"easing"`
-// }
-//
-// // Init returns the initial writers for the sequence.
-// func (t *Perspective) Init(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	for _, elem := range elems {
-// 		transform, priority, _ := elem.Read("transform", "perspective")
-//
-// 		func(e govfx.Elemental) {
-//
-// 			var r float64
-//
-// 			if govfx.IsPerspective(transform) {
-// 				mx, _ := govfx.ToPerspective(transform)
-// 				r = mx.Range
-// 			} else {
-// 				r = 0
-// 			}
-//
-// 			transform = fmt.Sprintf("Perspective(%.0fpx)", r)
-//
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.Write("transform", transform, priority)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
-//
-// // Next returns the writers for the next sequence.
-// func (t *Perspective) Next(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	easing := govfx.GetEasing(t.Easing)
-//
-// 	for _, elem := range elems {
-// 		transform, priority, _ := elem.Read("transform", "Perspective")
-//
-// 		func(e govfx.Elemental) {
-//
-// 			var r float64
-//
-// 			if govfx.IsPerspective(transform) {
-// 				mx, _ := govfx.ToPerspective(transform)
-// 				r = mx.Range
-// 			} else {
-// 				r = 0
-// 			}
-//
-// 			rd := float64(t.Value) - r
-//
-// 			rn := easing.Ease(govfx.EaseConfig{
-// 				Stat:         stats,
-// 				CurrentValue: r,
-// 				DeltaValue:   rd,
-// 			})
-//
-// 			e.EraseMore("transform", "matrix", false)
-//
-// 			transform = fmt.Sprintf("Perspective(%.0fpx)", rn)
-//
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.Write("transform", transform, priority)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
+// Perspective defines a sequence for animating the perspective function of the css
+// transform property in pixels.
+type Perspective struct {
+	Value  govfx.Target `govfx:"value"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	transform
+}
+
+// Init initializes the perspective value from the current transform of the element.
+func (t *Perspective) Init(elem govfx.Elemental) error {
+	return t.init(elem, t.Value, t.Easer, t.Easing, 0, axis{"perspective", 0})
+}
+
+// Update sets the perspective value for the timeline progress.
+func (t *Perspective) Update(delta float64, timeline float64) {
+	t.tween.Update(timeline)
+}
+
+// TransformOrder implements the govfx.Transformer interface.
+func (t *Perspective) TransformOrder() int {
+	return govfx.PerspectiveOrder
+}
+
+// Transform writes the perspective function to the supplied writer.
+func (t *Perspective) Transform(w io.Writer) {
+	w.Write([]byte("perspective(" + formatValue(t.tween.Value()) + "px)"))
+}
+
+// CSS writes the css output to the supplied writer.
+func (t *Perspective) CSS(w io.Writer) {
+	writeTransform(w, t)
+}
 
 //==============================================================================
@@ -0,0 +1,140 @@
+package animators
+
+import (
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/influx6/govfx"
+)
+
+//==============================================================================
+
+// Property provides a generic animator for numeric css properties, which
+// animates the property named by Name from its current value towards the
+// target value (which can be relative, eg "+=10"), clamped between Min and Max
+// and written out with Unit.
+// Registering it for a new property only requires a configured instance, eg:
+//
+//	govfx.RegisterSequence("opacity", animators.NewProperty("opacity", "").Clamp(0, 1))
+//
+// The tagged fields of the instance become the defaults of the animator,
+// hence the unit and bounds can still be overridden per animation.
+type Property struct {
+	Name   string       `govfx:"property"`
+	Unit   string       `govfx:"unit"`
+	Min    float64      `govfx:"min"`
+	Max    float64      `govfx:"max"`
+	Value  govfx.Target `govfx:"value"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	tween govfx.Tween
+}
+
+// NewProperty returns a new unbounded Property for the named css property
+// written out with the provided unit.
+func NewProperty(name string, unit string) Property {
+	return Property{
+		Name: name,
+		Unit: unit,
+		Min:  math.Inf(-1),
+		Max:  math.Inf(1),
+	}
+}
+
+// Clamp returns a copy of the property whose values are clamped between the
+// provided min and max.
+func (p Property) Clamp(min float64, max float64) Property {
+	p.Min = min
+	p.Max = max
+	return p
+}
+
+// Init initializes the property with the current value of the element, where
+// a target with a unit (eg "200px" or "+=2em") is animated and written out in
+// that unit.
+func (p *Property) Init(elem govfx.Elemental) error {
+	easer, err := easerOf(p.Easer, p.Easing)
+	if err != nil {
+		return err
+	}
+
+	p.Easer = easer
+
+	if p.Value.Unit != "" {
+		p.Unit = p.Value.Unit
+	}
+
+	from := p.start(elem)
+	p.tween = govfx.NewTween(from, p.Value.Resolve(from), p.Easer)
+	return nil
+}
+
+// start returns the current value of the property in the unit of the
+// property, converting a length in another unit (eg the pixels of a computed
+// style) within the context of the element. Unitless values and units which
+// are not css lengths are taken as they are.
+func (p *Property) start(elem govfx.Elemental) float64 {
+	value, _, ok := elem.Read(p.Name, "")
+	if !ok {
+		return 0
+	}
+
+	length, err := govfx.ParseLength(value)
+	if err != nil {
+		return govfx.ParseFloat(value)
+	}
+
+	unit, known := govfx.Unit(p.Unit)
+	if !known || strings.EqualFold(length.Unit, unit) {
+		return length.Value
+	}
+
+	if !length.IsCalc() && length.Unit == "" {
+		return length.Value
+	}
+
+	return length.ToUnit(unit, govfx.ContextOf(elem, p.Name)).Value
+}
+
+// Update sets the current value of the property for the timeline progress.
+func (p *Property) Update(delta float64, timeline float64) {
+	p.tween.Update(timeline)
+}
+
+// Seek sets the value of the property for the timeline progress, without a
+// previous value to blend from.
+func (p *Property) Seek(timeline float64) {
+	p.tween.Set(timeline)
+}
+
+// Blend blends the value of the property between its last fixed updates.
+func (p *Property) Blend(interpolate float64) {
+	p.tween.Blend(interpolate)
+}
+
+// Declare emits the current state of the property as a declaration.
+func (p *Property) Declare(decls *govfx.Declarations) {
+	current := p.clamp(p.tween.Value())
+
+	decls.Set(govfx.Declaration{
+		Property: p.Name,
+		Value:    strconv.FormatFloat(math.Round(current*1e4)/1e4, 'f', -1, 64),
+		Unit:     p.Unit,
+	})
+}
+
+// CSS writes out the current state of the property in css format to the provided
+// writer.
+func (p *Property) CSS(w io.Writer) {
+	govfx.DeclarationsOf(p).CSS(w)
+}
+
+// clamp returns the value clamped between the bounds of the property.
+func (p *Property) clamp(value float64) float64 {
+	return math.Max(p.Min, math.Min(p.Max, value))
+}
+
+//==============================================================================
@@ -1,250 +1,120 @@
 package animators
 
+import (
+	"io"
+
+	"github.com/influx6/govfx"
+)
+
+//==============================================================================
+
+// RotateX defines a sequence for animating the rotateX function of the css
+// transform property in degrees.
+type RotateX struct {
+	Value  govfx.Target `govfx:"value"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	transform
+}
+
+// Init initializes the rotateX value from the current transform of the element.
+func (t *RotateX) Init(elem govfx.Elemental) error {
+	return t.init(elem, t.Value, t.Easer, t.Easing, 0, axis{"rotateX", 0})
+}
+
+// Update sets the rotateX value for the timeline progress.
+func (t *RotateX) Update(delta float64, timeline float64) {
+	t.tween.Update(timeline)
+}
+
+// TransformOrder implements the govfx.Transformer interface.
+func (t *RotateX) TransformOrder() int {
+	return govfx.RotateOrder
+}
+
+// Transform writes the rotateX function to the supplied writer.
+func (t *RotateX) Transform(w io.Writer) {
+	w.Write([]byte("rotateX(" + formatValue(t.tween.Value()) + "deg)"))
+}
+
+// CSS writes the css output to the supplied writer.
+func (t *RotateX) CSS(w io.Writer) {
+	writeTransform(w, t)
+}
+
 //==============================================================================
 
-// // RotateY defines a sequence for animating css Rotate y-axes properties.
-// type RotateY struct {
-// 	Value  int    `govfx:"value"`
-// 	Easing string `govfx:"easing"`
-// }
-//
-// // Init returns the initial writers for the sequence.
-// func (t *RotateY) Init(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	for _, elem := range elems {
-// 		transform, priority, _ := elem.Read("transform", "rotate")
-//
-// 		func(e govfx.Elemental) {
-//
-// 			var y float64
-//
-// 			if govfx.IsMatrix(transform) {
-// 				mx, _ := govfx.ToMatrix2D(transform)
-// 				y = mx.RotationY
-// 			} else if govfx.IsRotation(transform) {
-// 				mx, _ := govfx.ToRotation(transform)
-// 				y = mx.Angle
-// 			} else {
-// 				y = 0
-// 			}
-//
-// 			transform = fmt.Sprintf("rotateY(%.0fdeg)", y)
-//
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.Write("transform", transform, priority)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
-//
-// // Next returns the writers for the next sequence.
-// func (t *RotateY) Next(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	easing := govfx.GetEasing(t.Easing)
-//
-// 	for _, elem := range elems {
-// 		transform, priority, _ := elem.Read("transform", "rotate")
-//
-// 		func(e govfx.Elemental) {
-//
-// 			var y float64
-//
-// 			if govfx.IsMatrix(transform) {
-// 				mx, _ := govfx.ToMatrix2D(transform)
-// 				y = mx.RotationY
-// 			} else if govfx.IsRotation(transform) {
-// 				mx, _ := govfx.ToRotation(transform)
-// 				y = mx.Angle
-// 			}
-//
-// 			yd := float64(t.Value) - y
-//
-// 			yn := easing.Ease(govfx.EaseConfig{
-// 				Stat:         stats,
-// 				CurrentValue: y,
-// 				DeltaValue:   yd,
-// 			})
-//
-// 			e.EraseMore("transform", "matrix", false)
-//
-// 			transform = fmt.Sprintf("rotateY(%.0fdeg)", yn)
-//
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.Write("transform", transform, priority)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
-//
-// //==============================================================================
-//
-// // RotateX defines a sequence for animating css Rotate x-axes properties.
-// type RotateX struct {
-// 	Value  int    `govfx:"value"`
-// 	Easing string `govfx:"easing"`
-// }
-//
-// // Init returns the initial writers for the sequence.
-// func (t *RotateX) Init(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	for _, elem := range elems {
-// 		transform, priority, _ := elem.Read("transform", "rotate")
-//
-// 		func(e govfx.Elemental) {
-//
-// 			var x float64
-//
-// 			if govfx.IsMatrix(transform) {
-// 				mx, _ := govfx.ToMatrix2D(transform)
-// 				x = mx.RotationX
-// 			} else if govfx.IsRotation(transform) {
-// 				mx, _ := govfx.ToRotation(transform)
-// 				x = mx.Angle
-// 			} else {
-// 				x = 0
-// 			}
-//
-// 			transform = fmt.Sprintf("rotateX(%.0fdeg)", x)
-//
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.Write("transform", transform, priority)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
-//
-// // Next returns the writers for the next sequence.
-// func (t *RotateX) Next(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	easing := govfx.GetEasing(t.Easing)
-//
-// 	for _, elem := range elems {
-// 		transform, priority, _ := elem.Read("transform", "rotate")
-//
-// 		func(e govfx.Elemental) {
-//
-// 			var x float64
-//
-// 			if govfx.IsMatrix(transform) {
-// 				mx, _ := govfx.ToMatrix2D(transform)
-// 				x = mx.RotationX
-// 			} else if govfx.IsRotation(transform) {
-// 				mx, _ := govfx.ToRotation(transform)
-// 				x = mx.Angle
-// 			}
-//
-// 			xd := float64(t.Value) - x
-//
-// 			xn := easing.Ease(govfx.EaseConfig{
-// 				Stat:         stats,
-// 				CurrentValue: x,
-// 				DeltaValue:   xd,
-// 			})
-//
-// 			e.EraseMore("transform", "matrix", false)
-// 			transform = fmt.Sprintf("rotateX(%.0fdeg)", xn)
-//
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.Write("transform", transform, priority)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
-//
-// //==============================================================================
-//
-// // Rotate defines a sequence for animating css Rotate x-axes properties.
-// type Rotate struct {
-// 	Value  int    `govfx:"value"`
-// 	Easing string `govfx:"easing"`
-// }
-//
-// // Init returns the initial writers for the sequence.
-// func (t *Rotate) Init(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	for _, elem := range elems {
-// 		transform, priority, _ := elem.Read("transform", "rotate")
-//
-// 		func(e govfx.Elemental) {
-//
-// 			var r float64
-//
-// 			if govfx.IsSimpleRotation(transform) {
-// 				mx, _ := govfx.ToRotation(transform)
-// 				r = mx.Angle
-// 			} else {
-// 				r = 0
-// 			}
-//
-// 			transform = fmt.Sprintf("rotate(%.0fdeg)", r)
-//
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.Write("transform", transform, priority)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
-//
-// // Next returns the writers for the next sequence.
-// func (t *Rotate) Next(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	easing := govfx.GetEasing(t.Easing)
-//
-// 	for _, elem := range elems {
-// 		transform, priority, _ := elem.Read("transform", "rotate")
-//
-// 		func(e govfx.Elemental) {
-//
-// 			var r float64
-//
-// 			if govfx.IsSimpleRotation(transform) {
-// 				mx, _ := govfx.ToRotation(transform)
-// 				r = mx.Angle
-// 			} else {
-// 				r = 0
-// 			}
-//
-// 			rd := float64(t.Value) - r
-//
-// 			rn := easing.Ease(govfx.EaseConfig{
-// 				Stat:         stats,
-// 				CurrentValue: r,
-// 				DeltaValue:   rd,
-// 			})
-//
-// 			e.EraseMore("transform", "matrix", false)
-//
-// 			transform = fmt.Sprintf("rotate(%.0fdeg)", rn)
-//
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.Write("transform", transform, priority)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
-//
+// RotateY defines a sequence for animating the rotateY function of the css
+// transform property in degrees.
+type RotateY struct {
+	Value  govfx.Target `govfx:"value"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	transform
+}
+
+// Init initializes the rotateY value from the current transform of the element.
+func (t *RotateY) Init(elem govfx.Elemental) error {
+	return t.init(elem, t.Value, t.Easer, t.Easing, 0, axis{"rotateY", 0})
+}
+
+// Update sets the rotateY value for the timeline progress.
+func (t *RotateY) Update(delta float64, timeline float64) {
+	t.tween.Update(timeline)
+}
+
+// TransformOrder implements the govfx.Transformer interface.
+func (t *RotateY) TransformOrder() int {
+	return govfx.RotateOrder
+}
+
+// Transform writes the rotateY function to the supplied writer.
+func (t *RotateY) Transform(w io.Writer) {
+	w.Write([]byte("rotateY(" + formatValue(t.tween.Value()) + "deg)"))
+}
+
+// CSS writes the css output to the supplied writer.
+func (t *RotateY) CSS(w io.Writer) {
+	writeTransform(w, t)
+}
+
+//==============================================================================
+
+// Rotate defines a sequence for animating the rotate function of the css
+// transform property in degrees.
+type Rotate struct {
+	Value  govfx.Target `govfx:"value"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	transform
+}
+
+// Init initializes the rotate value from the current transform of the element.
+func (t *Rotate) Init(elem govfx.Elemental) error {
+	return t.init(elem, t.Value, t.Easer, t.Easing, 0, axis{"rotate", 0}, axis{"rotateZ", 0})
+}
+
+// Update sets the rotate value for the timeline progress.
+func (t *Rotate) Update(delta float64, timeline float64) {
+	t.tween.Update(timeline)
+}
+
+// TransformOrder implements the govfx.Transformer interface.
+func (t *Rotate) TransformOrder() int {
+	return govfx.RotateOrder
+}
+
+// Transform writes the rotate function to the supplied writer.
+func (t *Rotate) Transform(w io.Writer) {
+	w.Write([]byte("rotate(" + formatValue(t.tween.Value()) + "deg)"))
+}
+
+// CSS writes the css output to the supplied writer.
+func (t *Rotate) CSS(w io.Writer) {
+	writeTransform(w, t)
+}
+
 //==============================================================================
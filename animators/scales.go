@@ -1,135 +1,83 @@
 package animators
 
+import (
+	"io"
+
+	"github.com/influx6/govfx"
+)
+
 //==============================================================================
 
-// // ScaleY defines a sequence for animating css Scale y-axes properties.
-// type ScaleY struct {
-// 	Value  float64 `govfx:"value"`
-// 	Easing string  `govfx:"easing"`
-// }
-//
-// // Init returns the initial writers for the sequence.
-// func (t *ScaleY) Init(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	for _, elem := range elems {
-// 		func(e govfx.Elemental) {
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.Write("transform", "scaleY(1.0)", false)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
-//
-// // Next returns the writers for the next sequence.
-// func (t *ScaleY) Next(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	easing := govfx.GetEasing(t.Easing)
-//
-// 	for _, elem := range elems {
-// 		transform, priority, _ := elem.Read("transform", "scale")
-//
-// 		func(e govfx.Elemental) {
-//
-// 			var y float64
-//
-// 			if govfx.IsMatrix(transform) {
-// 				mx, _ := govfx.ToMatrix2D(transform)
-// 				y = mx.ScaleY
-// 			} else if govfx.IsScale(transform) {
-// 				mx, _ := govfx.ToScale(transform)
-// 				y = mx.Y
-// 			}
-//
-// 			yd := float64(t.Value) - y
-//
-// 			yn := easing.Ease(govfx.EaseConfig{
-// 				Stat:         stats,
-// 				CurrentValue: y,
-// 				DeltaValue:   yd,
-// 			})
-//
-// 			transform = fmt.Sprintf("scaleY(%.2f)", yn)
-// 			e.EraseMore("transform", "matrix", false)
-//
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.Write("transform", transform, priority)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
-//
-// //==============================================================================
-//
-// // ScaleX defines a sequence for animating css Scale x-axes properties.
-// type ScaleX struct {
-// 	Value  float64 `govfx:"value"`
-// 	Easing string  `govfx:"easing"`
-// }
-//
-// // Init returns the initial writers for the sequence.
-// func (t *ScaleX) Init(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	for _, elem := range elems {
-// 		func(e govfx.Elemental) {
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.Write("transform", "scaleX(1.0)", false)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
-//
-// // Next returns the writers for the next sequence.
-// func (t *ScaleX) Next(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	easing := govfx.GetEasing(t.Easing)
-//
-// 	for _, elem := range elems {
-// 		transform, priority, _ := elem.Read("transform", "scale")
-//
-// 		func(e govfx.Elemental) {
-//
-// 			var x float64
-//
-// 			if govfx.IsMatrix(transform) {
-// 				mx, _ := govfx.ToMatrix2D(transform)
-// 				x = mx.ScaleX
-// 			} else if govfx.IsScale(transform) {
-// 				mx, _ := govfx.ToScale(transform)
-// 				x = mx.X
-// 			}
-//
-// 			xd := float64(t.Value) - x
-//
-// 			xn := easing.Ease(govfx.EaseConfig{
-// 				Stat:         stats,
-// 				CurrentValue: x,
-// 				DeltaValue:   xd,
-// 			})
-//
-// 			e.EraseMore("transform", "matrix", false)
-//
-// 			transform = fmt.Sprintf("scaleX(%.2f)", xn)
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.WriteMore("transform", transform, priority)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
+// ScaleX defines a sequence for animating the scaleX function of the css
+// transform property.
+type ScaleX struct {
+	Value  govfx.Target `govfx:"value"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	transform
+}
+
+// Init initializes the scaleX value from the current transform of the element.
+func (t *ScaleX) Init(elem govfx.Elemental) error {
+	return t.init(elem, t.Value, t.Easer, t.Easing, 1, axis{"scaleX", 0}, axis{"scale", 0})
+}
+
+// Update sets the scaleX value for the timeline progress.
+func (t *ScaleX) Update(delta float64, timeline float64) {
+	t.tween.Update(timeline)
+}
+
+// TransformOrder implements the govfx.Transformer interface.
+func (t *ScaleX) TransformOrder() int {
+	return govfx.ScaleOrder
+}
+
+// Transform writes the scaleX function to the supplied writer.
+func (t *ScaleX) Transform(w io.Writer) {
+	w.Write([]byte("scaleX(" + formatValue(t.tween.Value()) + ")"))
+}
+
+// CSS writes the css output to the supplied writer.
+func (t *ScaleX) CSS(w io.Writer) {
+	writeTransform(w, t)
+}
+
+//==============================================================================
+
+// ScaleY defines a sequence for animating the scaleY function of the css
+// transform property.
+type ScaleY struct {
+	Value  govfx.Target `govfx:"value"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	transform
+}
+
+// Init initializes the scaleY value from the current transform of the element.
+func (t *ScaleY) Init(elem govfx.Elemental) error {
+	return t.init(elem, t.Value, t.Easer, t.Easing, 1, axis{"scaleY", 0}, axis{"scale", 1}, axis{"scale", 0})
+}
+
+// Update sets the scaleY value for the timeline progress.
+func (t *ScaleY) Update(delta float64, timeline float64) {
+	t.tween.Update(timeline)
+}
+
+// TransformOrder implements the govfx.Transformer interface.
+func (t *ScaleY) TransformOrder() int {
+	return govfx.ScaleOrder
+}
+
+// Transform writes the scaleY function to the supplied writer.
+func (t *ScaleY) Transform(w io.Writer) {
+	w.Write([]byte("scaleY(" + formatValue(t.tween.Value()) + ")"))
+}
+
+// CSS writes the css output to the supplied writer.
+func (t *ScaleY) CSS(w io.Writer) {
+	writeTransform(w, t)
+}
 
 //==============================================================================
@@ -0,0 +1,126 @@
+package animators
+
+import (
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/influx6/govfx"
+)
+
+//==============================================================================
+
+// axis defines a argument of a transform function from which the starting
+// value of a transform sequence can be read.
+type axis struct {
+	name  string
+	index int
+}
+
+// transform provides the shared state of the transform sequences, which
+// animate a single value from the current transform of the element towards
+// their target.
+type transform struct {
+	tween govfx.Tween
+}
+
+// init sets the tween from the current value of the axes in the transform of
+// the element towards the target, resolved against the current value. A
+// target with a unit (eg "50%" or "+=2em") is resolved into pixels within the
+// transform context of the element.
+func (t *transform) init(elem govfx.Elemental, target govfx.Target, easer govfx.Easing, easing string, def float64, axes ...axis) error {
+	easer, err := easerOf(easer, easing)
+	if err != nil {
+		return err
+	}
+
+	if target.Unit != "" {
+		ctx := govfx.TransformContextOf(elem)
+
+		lctx := ctx.X
+		if strings.HasSuffix(axes[0].name, "Y") {
+			lctx = ctx.Y
+		}
+
+		target.Value, target.Unit = target.Length().Pixels(lctx), ""
+	}
+
+	from := readTransform(elem, def, axes...)
+	t.tween = govfx.NewTween(from, target.Resolve(from), easer)
+	return nil
+}
+
+// Seek sets the value of the transform for the timeline progress, without a
+// previous value to blend from.
+func (t *transform) Seek(timeline float64) {
+	t.tween.Set(timeline)
+}
+
+// Blend blends the value of the transform between its last fixed updates.
+func (t *transform) Blend(interpolate float64) {
+	t.tween.Blend(interpolate)
+}
+
+// readTransform returns the value of the first of the provided axes found in
+// the transform of the element, else from the decomposition of the transform
+// (eg a computed matrix()) for the first axis, else the default value.
+func readTransform(elem govfx.Elemental, def float64, axes ...axis) float64 {
+	value, _, ok := elem.Read("transform", "")
+	if !ok {
+		return def
+	}
+
+	ctx := govfx.TransformContextOf(elem)
+
+	for _, ax := range axes {
+		if val, found := govfx.TransformArgIn(value, ax.name, ax.index, ctx); found {
+			return val
+		}
+	}
+
+	mx, err := govfx.ParseMatrixIn(value, ctx)
+	if err != nil {
+		return def
+	}
+
+	dc, ok := mx.Decompose()
+	if !ok {
+		return def
+	}
+
+	if val, found := dc.Component(axes[0].name); found {
+		return val
+	}
+
+	return def
+}
+
+// easerOf returns the easer if provided, else the easing parsed from its css
+// value, where no easing uses the default easing and a invalid one returns a
+// govfx.EasingError.
+func easerOf(easer govfx.Easing, easing string) (govfx.Easing, error) {
+	if easer != nil {
+		return easer, nil
+	}
+
+	if strings.TrimSpace(easing) == "" {
+		return govfx.GetEasing(""), nil
+	}
+
+	return govfx.ParseEasing(easing)
+}
+
+// writeTransform writes the transform function of the transformer as a
+// standalone transform declaration.
+func writeTransform(w io.Writer, tf govfx.Transformer) {
+	w.Write([]byte("transform: "))
+	tf.Transform(w)
+}
+
+// formatValue returns the value as a string rounded to two decimal places.
+func formatValue(value float64) string {
+	return strconv.FormatFloat(math.Round(value*100)/100, 'f', -1, 64)
+}
+
+//==============================================================================
@@ -1,181 +1,83 @@
 package animators
 
+import (
+	"io"
+
+	"github.com/influx6/govfx"
+)
+
 //==============================================================================
 
-// // TranslateY defines a sequence for animating css translate y-axes properties.
-// type TranslateY struct {
-// 	Value  int    `govfx:"value"`
-// 	Easing string `govfx:"easing"`
-// }
-//
-// // Init returns the initial writers for the sequence.
-// func (t TranslateY) Init(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	for _, elem := range elems {
-// 		transform, priority, _ := elem.Read("transform", "translate")
-// 		position, pr, _ := elem.Read("position", "")
-//
-// 		if utils.MatchAny(position, "none", "") {
-// 			position = "relative"
-// 		}
-//
-// 		func(e govfx.Elemental) {
-//
-// 			var x, y float64
-//
-// 			if govfx.IsMatrix(transform) {
-// 				mx, _ := govfx.ToMatrix2D(transform)
-// 				x, y = mx.PositionX, mx.PositionY
-// 			} else if govfx.IsTranslation(transform) {
-// 				mx, _ := govfx.ToTranslation(transform)
-// 				x, y = mx.X, mx.Y
-// 			} else {
-// 				// x, y = govfx.Position(e)
-// 				x, y = 0, 0
-// 			}
-//
-// 			transform = fmt.Sprintf("translate(%.0fpx, %.0fpx)", x, y)
-//
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.Write("transform", transform, priority)
-// 				e.Write("position", position, pr)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
-//
-// // Next returns the writers for the next sequence.
-// func (t TranslateY) Next(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	easing := govfx.GetEasing(t.Easing)
-//
-// 	for _, elem := range elems {
-// 		transform, priority, _ := elem.Read("transform", "translate")
-//
-// 		func(e govfx.Elemental) {
-//
-// 			var x, y float64
-//
-// 			if govfx.IsMatrix(transform) {
-// 				mx, _ := govfx.ToMatrix2D(transform)
-// 				x, y = mx.PositionX, mx.PositionY
-// 			} else if govfx.IsTranslation(transform) {
-// 				mx, _ := govfx.ToTranslation(transform)
-// 				x, y = mx.X, mx.Y
-// 			}
-//
-// 			yd := float64(t.Value) - y
-//
-// 			yn := easing.Ease(govfx.EaseConfig{
-// 				Stat:         stats,
-// 				CurrentValue: y,
-// 				DeltaValue:   yd,
-// 			})
-//
-// 			transform = fmt.Sprintf("translate(%.0fpx, %.0fpx)", x, yn)
-// 			e.EraseMore("transform", "matrix", false)
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.WriteMore("transform", transform, priority)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
-//
-// //==============================================================================
-//
-// // TranslateX defines a sequence for animating css translate x-axes properties.
-// type TranslateX struct {
-// 	Value  int    `govfx:"value"`
-// 	Easing string `govfx:"easing"`
-// }
-//
-// // Init returns the initial writers for the sequence.
-// func (t TranslateX) Init(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	for _, elem := range elems {
-// 		transform, priority, _ := elem.Read("transform", "translate")
-// 		position, pr, _ := elem.Read("position", "")
-//
-// 		if utils.MatchAny(position, "none", "") {
-// 			position = "relative"
-// 		}
-//
-// 		func(e govfx.Elemental) {
-//
-// 			var x, y float64
-//
-// 			if govfx.IsMatrix(transform) {
-// 				mx, _ := govfx.ToMatrix2D(transform)
-// 				x, y = mx.PositionX, mx.PositionX
-// 			} else if govfx.IsTranslation(transform) {
-// 				mx, _ := govfx.ToTranslation(transform)
-// 				x, y = mx.X, mx.X
-// 			} else {
-// 				// x, y = govfx.Position(e)
-// 				x, y = 0, 0
-// 			}
-//
-// 			transform = fmt.Sprintf("translate(%.0fpx, %.0fpx)", x, y)
-//
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.Write("transform", transform, priority)
-// 				e.Write("position", position, pr)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
-//
-// // Next returns the writers for the next sequence.
-// func (t TranslateX) Next(stats govfx.Stats, elems govfx.Elementals) govfx.DeferWriters {
-// 	var writers govfx.DeferWriters
-//
-// 	easing := govfx.GetEasing(t.Easing)
-//
-// 	for _, elem := range elems {
-// 		transform, priority, _ := elem.Read("transform", "translate")
-//
-// 		func(e govfx.Elemental) {
-//
-// 			var x, y float64
-//
-// 			if govfx.IsMatrix(transform) {
-// 				mx, _ := govfx.ToMatrix2D(transform)
-// 				x, y = mx.PositionX, mx.PositionX
-// 			} else if govfx.IsTranslation(transform) {
-// 				mx, _ := govfx.ToTranslation(transform)
-// 				x, y = mx.X, mx.X
-// 			}
-//
-// 			xd := float64(t.Value) - x
-//
-// 			xn := easing.Ease(govfx.EaseConfig{
-// 				Stat:         stats,
-// 				CurrentValue: x,
-// 				DeltaValue:   xd,
-// 			})
-//
-// 			transform = fmt.Sprintf("translate(%.0fpx, %.0fpx)", xn, y)
-// 			e.EraseMore("transform", "matrix", false)
-// 			writers = append(writers, govfx.NewWriter(func() {
-// 				e.WriteMore("transform", transform, priority)
-// 				e.Sync()
-// 			}))
-// 		}(elem)
-// 	}
-//
-// 	return writers
-// }
+// TranslateX defines a sequence for animating the translateX function of the css
+// transform property in pixels.
+type TranslateX struct {
+	Value  govfx.Target `govfx:"value"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	transform
+}
+
+// Init initializes the translateX value from the current transform of the element.
+func (t *TranslateX) Init(elem govfx.Elemental) error {
+	return t.init(elem, t.Value, t.Easer, t.Easing, 0, axis{"translateX", 0}, axis{"translate", 0}, axis{"translate3d", 0})
+}
+
+// Update sets the translateX value for the timeline progress.
+func (t *TranslateX) Update(delta float64, timeline float64) {
+	t.tween.Update(timeline)
+}
+
+// TransformOrder implements the govfx.Transformer interface.
+func (t *TranslateX) TransformOrder() int {
+	return govfx.TranslateOrder
+}
+
+// Transform writes the translateX function to the supplied writer.
+func (t *TranslateX) Transform(w io.Writer) {
+	w.Write([]byte("translateX(" + formatValue(t.tween.Value()) + "px)"))
+}
+
+// CSS writes the css output to the supplied writer.
+func (t *TranslateX) CSS(w io.Writer) {
+	writeTransform(w, t)
+}
+
+//==============================================================================
+
+// TranslateY defines a sequence for animating the translateY function of the css
+// transform property in pixels.
+type TranslateY struct {
+	Value  govfx.Target `govfx:"value"`
+	Easing string       `govfx:"easing"`
+	Easer  govfx.Easing `govfx:"easer"`
+
+	transform
+}
+
+// Init initializes the translateY value from the current transform of the element.
+func (t *TranslateY) Init(elem govfx.Elemental) error {
+	return t.init(elem, t.Value, t.Easer, t.Easing, 0, axis{"translateY", 0}, axis{"translate", 1}, axis{"translate3d", 1})
+}
+
+// Update sets the translateY value for the timeline progress.
+func (t *TranslateY) Update(delta float64, timeline float64) {
+	t.tween.Update(timeline)
+}
+
+// TransformOrder implements the govfx.Transformer interface.
+func (t *TranslateY) TransformOrder() int {
+	return govfx.TranslateOrder
+}
+
+// Transform writes the translateY function to the supplied writer.
+func (t *TranslateY) Transform(w io.Writer) {
+	w.Write([]byte("translateY(" + formatValue(t.tween.Value()) + "px)"))
+}
+
+// CSS writes the css output to the supplied writer.
+func (t *TranslateY) CSS(w io.Writer) {
+	writeTransform(w, t)
+}
 
 //==============================================================================
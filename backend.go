@@ -0,0 +1,256 @@
+package govfx
+
+import (
+	"sync"
+
+	"github.com/gopherjs/gopherjs/js"
+	"honnef.co/go/js/dom"
+)
+
+//==============================================================================
+
+// DOMElement defines the minimal element surface govfx needs from a node. Any
+// dom.Element satisfies it, as does the in-memory MemoryElement used by the
+// headless backend.
+type DOMElement interface {
+	GetAttribute(string) string
+	SetAttribute(string, string)
+}
+
+// Rect defines the bounding box of an element relative to the viewport.
+type Rect struct {
+	Top    float64
+	Right  float64
+	Bottom float64
+	Left   float64
+	Width  float64
+	Height float64
+}
+
+// Backend defines the DOM surface used by govfx, allowing the animation
+// system to run against the browser or against an in-memory document.
+type Backend interface {
+	QuerySelector(selector string) DOMElement
+	QuerySelectorAll(selector string) []DOMElement
+	ComputedStyle(elem DOMElement, pseudo string) (ComputedStyleMap, error)
+	ComputedProperty(elem DOMElement, pseudo string, prop string) (*ComputedStyle, error)
+	BoundingRect(elem DOMElement) Rect
+	Parent(elem DOMElement) DOMElement
+	Viewport() (float64, float64)
+	StyleProperty(elem DOMElement, prop string) (string, bool)
+	SetStyleProperty(elem DOMElement, prop string, value string, important bool)
+	RemoveStyleProperty(elem DOMElement, prop string)
+}
+
+// StyleObserver defines a Backend which can notify changes to the class or
+// style attribute of a element, allowing the computed styles cached for it to
+// be invalidated. ObserveStyle returns a function which stops observing the
+// element, else nil if the element can not be observed.
+type StyleObserver interface {
+	ObserveStyle(elem DOMElement, fn func()) func()
+}
+
+//==============================================================================
+
+var backend Backend
+var backendLock sync.RWMutex
+
+// UseBackend sets the backend to be used by govfx for all its DOM operations.
+// By default the browser backend is used within a browser and the headless
+// backend everywhere else.
+func UseBackend(b Backend) {
+	backendLock.Lock()
+	defer backendLock.Unlock()
+	backend = b
+}
+
+// GetBackend returns the current backend in use by govfx.
+func GetBackend() Backend {
+	backendLock.RLock()
+	defer backendLock.RUnlock()
+	return backend
+}
+
+// unwrapElement returns the underline DOMElement for the provided element if it
+// is a govfx Element or wraps one through a Unwrap() method (eg the elements
+// of keyframes), else returns the element as is.
+func unwrapElement(elem DOMElement) DOMElement {
+	for {
+		switch em := elem.(type) {
+		case *Element:
+			return em.DOMElement
+		case interface{ Unwrap() Elemental }:
+			elem = em.Unwrap()
+		default:
+			return elem
+		}
+	}
+}
+
+//==============================================================================
+
+// BrowserBackend returns a Backend which uses the browser's DOM API.
+func BrowserBackend() Backend {
+	return browserBackend{}
+}
+
+// browserBackend implements the Backend interface using the honnef.co/go/js/dom
+// package.
+type browserBackend struct{}
+
+// QuerySelector returns the first element matching the selector else nil.
+func (browserBackend) QuerySelector(selector string) DOMElement {
+	node := Document().QuerySelector(selector)
+	if node == nil {
+		return nil
+	}
+
+	return node
+}
+
+// QuerySelectorAll returns all elements matching the selector.
+func (browserBackend) QuerySelectorAll(selector string) []DOMElement {
+	var elems []DOMElement
+
+	for _, item := range Document().QuerySelectorAll(selector) {
+		elems = append(elems, item)
+	}
+
+	return elems
+}
+
+// ComputedStyle returns the computed style map for the element.
+func (browserBackend) ComputedStyle(elem DOMElement, pseudo string) (ComputedStyleMap, error) {
+	de, ok := unwrapElement(elem).(dom.Element)
+	if !ok {
+		return nil, ErrInvalidElement
+	}
+
+	return GetComputedStyleMap(de, pseudo)
+}
+
+// ComputedProperty returns the computed style of the single property of the
+// element.
+func (browserBackend) ComputedProperty(elem DOMElement, pseudo string, prop string) (*ComputedStyle, error) {
+	de, ok := unwrapElement(elem).(dom.Element)
+	if !ok {
+		return nil, ErrInvalidElement
+	}
+
+	return GetComputedStyleProperty(de, pseudo, prop)
+}
+
+// BoundingRect returns the bounding client rect of the element.
+func (browserBackend) BoundingRect(elem DOMElement) Rect {
+	de, ok := unwrapElement(elem).(dom.Element)
+	if !ok {
+		return Rect{}
+	}
+
+	rect := de.GetBoundingClientRect()
+
+	return Rect{
+		Top:    rect.Top,
+		Right:  rect.Right,
+		Bottom: rect.Bottom,
+		Left:   rect.Left,
+		Width:  rect.Width,
+		Height: rect.Height,
+	}
+}
+
+// Parent returns the parent element of the element else nil if it has none.
+func (browserBackend) Parent(elem DOMElement) DOMElement {
+	de, ok := unwrapElement(elem).(dom.Element)
+	if !ok {
+		return nil
+	}
+
+	parent := de.ParentElement()
+	if parent == nil {
+		return nil
+	}
+
+	return parent
+}
+
+// Viewport returns the width and height of the window's viewport.
+func (browserBackend) Viewport() (float64, float64) {
+	win := Window()
+	return float64(win.InnerWidth()), float64(win.InnerHeight())
+}
+
+// StyleProperty returns the inline value of the property on the element and
+// whether it is important, where a empty value means it is not set.
+func (browserBackend) StyleProperty(elem DOMElement, prop string) (string, bool) {
+	style := inlineStyleOf(elem)
+	if style == nil {
+		return "", false
+	}
+
+	return style.GetPropertyValue(prop), style.GetPropertyPriority(prop) == "important"
+}
+
+// SetStyleProperty sets the inline value of the property on the element
+// through style.setProperty, leaving its other inline declarations as is.
+func (browserBackend) SetStyleProperty(elem DOMElement, prop string, value string, important bool) {
+	style := inlineStyleOf(elem)
+	if style == nil {
+		return
+	}
+
+	var priority string
+	if important {
+		priority = "important"
+	}
+
+	style.SetProperty(prop, value, priority)
+}
+
+// RemoveStyleProperty removes the inline value of the property on the element.
+func (browserBackend) RemoveStyleProperty(elem DOMElement, prop string) {
+	if style := inlineStyleOf(elem); style != nil {
+		style.RemoveProperty(prop)
+	}
+}
+
+// ObserveStyle calls the function whenever the class or style attribute of the
+// element changes, using a MutationObserver, returning a function which
+// disconnects it. Returns nil where MutationObserver is not supported.
+func (browserBackend) ObserveStyle(elem DOMElement, fn func()) func() {
+	de, ok := unwrapElement(elem).(dom.Element)
+	if !ok {
+		return nil
+	}
+
+	observer := js.Global.Get("MutationObserver")
+	if observer == nil || observer == js.Undefined {
+		return nil
+	}
+
+	mo := observer.New(func(*js.Object, *js.Object) { fn() })
+	mo.Call("observe", de.Underlying(), js.M{
+		"attributes":      true,
+		"attributeFilter": []string{"class", "style"},
+	})
+
+	return func() { mo.Call("disconnect") }
+}
+
+// inlineStyleOf returns the inline style declaration of the element, which
+// exists for html and svg elements alike, else nil.
+func inlineStyleOf(elem DOMElement) *dom.CSSStyleDeclaration {
+	de, ok := unwrapElement(elem).(dom.Element)
+	if !ok {
+		return nil
+	}
+
+	style := de.Underlying().Get("style")
+	if style == nil || style == js.Undefined {
+		return nil
+	}
+
+	return &dom.CSSStyleDeclaration{Object: style}
+}
+
+//==============================================================================
@@ -0,0 +1,159 @@
+package govfx
+
+import (
+	"sync"
+	"time"
+
+	"github.com/influx6/faux/loop"
+)
+
+//==============================================================================
+
+// Clock defines a source of time for timers and timelines. A Clock which also
+// implements the loop.GameEngine interface will be used to drive the loop of
+// the timelines using it, allowing time to be fully controlled.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock defines a Clock which uses the system time.
+type SystemClock struct{}
+
+// Now returns the current system time.
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// clockOf returns the giving clock or the SystemClock if it is nil.
+func clockOf(c Clock) Clock {
+	if c == nil {
+		return SystemClock{}
+	}
+
+	return c
+}
+
+//==============================================================================
+
+// ManualClock defines a virtual clock whose time only changes when advanced.
+// It implements the loop.GameEngine interface, where every call to Step
+// advances the clock and runs all registered loops once, which allows
+// timelines to be stepped frame by frame deterministically.
+type ManualClock struct {
+	rl   sync.RWMutex
+	now  time.Time
+	subs []*manualSub
+}
+
+// NewManualClock returns a new ManualClock set at the provided time.
+func NewManualClock(start time.Time) *ManualClock {
+	mc := ManualClock{now: start}
+	return &mc
+}
+
+// Now returns the current time of the clock.
+func (m *ManualClock) Now() time.Time {
+	m.rl.RLock()
+	defer m.rl.RUnlock()
+	return m.now
+}
+
+// Advance moves the clock forward by the provided duration without running
+// the registered loops.
+func (m *ManualClock) Advance(d time.Duration) {
+	m.rl.Lock()
+	defer m.rl.Unlock()
+	m.now = m.now.Add(d)
+}
+
+// Step advances the clock by the provided duration and runs all registered
+// loops once as a single frame. Loops registered during a step will only be
+// run from the next step.
+func (m *ManualClock) Step(d time.Duration) {
+	m.Advance(d)
+
+	m.rl.RLock()
+	subs := make([]*manualSub, len(m.subs))
+	copy(subs, m.subs)
+	m.rl.RUnlock()
+
+	for _, sub := range subs {
+		if sub.ended() {
+			continue
+		}
+
+		sub.mux(d.Seconds())
+	}
+}
+
+// Frames steps the clock the provided number of times using the duration as
+// the length of each frame.
+func (m *ManualClock) Frames(count int, d time.Duration) {
+	for i := 0; i < count; i++ {
+		m.Step(d)
+	}
+}
+
+// Loops returns the total loops currently registered with the clock.
+func (m *ManualClock) Loops() int {
+	m.rl.RLock()
+	defer m.rl.RUnlock()
+	return len(m.subs)
+}
+
+// Loop registers the giving callback to be called on every Step.
+func (m *ManualClock) Loop(mx loop.Mux, queue int) loop.Looper {
+	sub := manualSub{clock: m, mux: mx}
+
+	m.rl.Lock()
+	defer m.rl.Unlock()
+	m.subs = append(m.subs, &sub)
+
+	return &sub
+}
+
+// remove removes the subscriber from the clock.
+func (m *ManualClock) remove(sub *manualSub) {
+	m.rl.Lock()
+	defer m.rl.Unlock()
+
+	for ind, item := range m.subs {
+		if item != sub {
+			continue
+		}
+
+		m.subs = append(m.subs[:ind], m.subs[ind+1:]...)
+		return
+	}
+}
+
+// manualSub defines a loop subscriber for the ManualClock, implements the
+// loop.Looper interface.
+type manualSub struct {
+	rl    sync.RWMutex
+	clock *ManualClock
+	mux   loop.Mux
+	done  bool
+}
+
+// End removes the subscriber from the clock.
+func (s *manualSub) End(f ...func()) {
+	s.rl.Lock()
+	s.done = true
+	s.rl.Unlock()
+
+	s.clock.remove(s)
+
+	for _, fx := range f {
+		fx()
+	}
+}
+
+// ended returns true/false if the subscriber has ended.
+func (s *manualSub) ended() bool {
+	s.rl.RLock()
+	defer s.rl.RUnlock()
+	return s.done
+}
+
+//==============================================================================
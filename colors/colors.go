@@ -0,0 +1,232 @@
+// Package colors implements the parsing of css colors together with the
+// conversion and interpolation of colors across the sRGB, linear-sRGB, HSL,
+// Lab, LCH, OKLab and OKLCH color spaces.
+package colors
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+//==============================================================================
+
+// ErrInvalidColor defines the error returned when a color value can not be
+// parsed.
+var ErrInvalidColor = errors.New("Invalid Color")
+
+// Color defines a color in the sRGB color space, where the red, green, blue
+// and alpha values are between 0 and 1. Colors converted from other spaces can
+// be outside of this range, which is clamped when written out.
+type Color struct {
+	R float64
+	G float64
+	B float64
+	A float64
+}
+
+// RGB returns a new opaque Color from the red, green and blue values between 0
+// and 255.
+func RGB(red, green, blue float64) Color {
+	return Color{R: red / 255, G: green / 255, B: blue / 255, A: 1}
+}
+
+// RGBA writes out the color values in RGBA format.
+func (c Color) RGBA() string {
+	return fmt.Sprintf("rgba(%d, %d, %d, %s)", channel(c.R), channel(c.G), channel(c.B), alphaOf(c.A))
+}
+
+// RGBString writes out the color values in RGB format.
+func (c Color) RGBString() string {
+	return fmt.Sprintf("rgb(%d, %d, %d)", channel(c.R), channel(c.G), channel(c.B))
+}
+
+// Hex writes out the color values in hex format, including the alpha value when
+// the color is not opaque.
+func (c Color) Hex() string {
+	hex := fmt.Sprintf("#%02x%02x%02x", channel(c.R), channel(c.G), channel(c.B))
+	if c.A >= 1 {
+		return hex
+	}
+
+	return hex + fmt.Sprintf("%02x", int(math.Round(clamp(c.A)*255)))
+}
+
+// String returns the color in RGB format when it is opaque else in RGBA format.
+func (c Color) String() string {
+	if c.A >= 1 {
+		return c.RGBString()
+	}
+
+	return c.RGBA()
+}
+
+// channel returns the color channel value between 0 and 255.
+func channel(value float64) int {
+	return int(math.Round(clamp(value) * 255))
+}
+
+// alphaOf returns the alpha value as a string rounded to two decimal places.
+func alphaOf(value float64) string {
+	return strconv.FormatFloat(math.Round(clamp(value)*100)/100, 'f', -1, 64)
+}
+
+// clamp returns the value clamped between 0 and 1.
+func clamp(value float64) float64 {
+	return math.Max(0, math.Min(1, value))
+}
+
+//==============================================================================
+
+// Parse parses a css color value, which can be a hex color (#rgb, #rgba,
+// #rrggbb, #rrggbbaa), a rgb(), rgba(), hsl() or hsla() function in either
+// the comma or space separated syntax, a named color or transparent.
+func Parse(value string) (Color, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+
+	if value == "transparent" {
+		return Color{}, nil
+	}
+
+	if rgb, ok := namedColors[value]; ok {
+		return RGB(rgb[0], rgb[1], rgb[2]), nil
+	}
+
+	if strings.HasPrefix(value, "#") {
+		return parseHex(value[1:])
+	}
+
+	start := strings.Index(value, "(")
+	if start < 0 || !strings.HasSuffix(value, ")") {
+		return Color{}, ErrInvalidColor
+	}
+
+	name := strings.TrimSpace(value[:start])
+	args := strings.FieldsFunc(value[start+1:len(value)-1], func(r rune) bool {
+		return r == ',' || r == ' ' || r == '/'
+	})
+
+	if len(args) != 3 && len(args) != 4 {
+		return Color{}, ErrInvalidColor
+	}
+
+	hue := []string{"", "%"}
+	if name == "hsl" || name == "hsla" {
+		hue = []string{"", "deg", "turn", "grad", "rad"}
+	}
+
+	if !isChannel(args[0], hue...) {
+		return Color{}, ErrInvalidColor
+	}
+
+	for _, arg := range args[1:] {
+		if !isChannel(arg, "", "%") {
+			return Color{}, ErrInvalidColor
+		}
+	}
+
+	alpha := 1.0
+	if len(args) == 4 {
+		alpha = percentOf(args[3], 1)
+	}
+
+	switch name {
+	case "rgb", "rgba":
+		return Color{
+			R: percentOf(args[0], 255) / 255,
+			G: percentOf(args[1], 255) / 255,
+			B: percentOf(args[2], 255) / 255,
+			A: alpha,
+		}, nil
+	case "hsl", "hsla":
+		hue := parseNumber(args[0])
+		switch {
+		case strings.HasSuffix(args[0], "turn"):
+			hue *= 360
+		case strings.HasSuffix(args[0], "grad"):
+			hue *= 0.9
+		case strings.HasSuffix(args[0], "rad"):
+			hue *= 180 / math.Pi
+		}
+
+		return FromHSL(hue, parseNumber(args[1])/100, parseNumber(args[2])/100, alpha), nil
+	}
+
+	return Color{}, ErrInvalidColor
+}
+
+// parseHex parses the digits of a hex color with 3, 4, 6 or 8 digits.
+func parseHex(hex string) (Color, error) {
+	if len(hex) == 3 || len(hex) == 4 {
+		var long string
+
+		for _, digit := range hex {
+			long += string(digit) + string(digit)
+		}
+
+		hex = long
+	}
+
+	if len(hex) != 6 && len(hex) != 8 {
+		return Color{}, ErrInvalidColor
+	}
+
+	if len(hex) == 6 {
+		hex += "ff"
+	}
+
+	num, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return Color{}, ErrInvalidColor
+	}
+
+	return Color{
+		R: float64(num>>24&0xff) / 255,
+		G: float64(num>>16&0xff) / 255,
+		B: float64(num>>8&0xff) / 255,
+		A: float64(num&0xff) / 255,
+	}, nil
+}
+
+// numberMatch defines a matcher for the leading number of a css value.
+var numberMatch = regexp.MustCompile("^[-+]?(\\d+\\.?\\d*|\\.\\d+)([eE][-+]?\\d+)?")
+
+// parseNumber parses the leading number of a css value keeping its sign.
+func parseNumber(value string) float64 {
+	num, _ := strconv.ParseFloat(numberMatch.FindString(strings.TrimSpace(value)), 64)
+	return num
+}
+
+// isChannel returns true if the value is a number followed by one of the
+// provided units.
+func isChannel(value string, units ...string) bool {
+	num := numberMatch.FindString(value)
+	if num == "" {
+		return false
+	}
+
+	for _, unit := range units {
+		if value[len(num):] == unit {
+			return true
+		}
+	}
+
+	return false
+}
+
+// percentOf parses the value, where a percentage is taken of the provided
+// maximum.
+func percentOf(value string, max float64) float64 {
+	num := parseNumber(value)
+
+	if strings.HasSuffix(value, "%") {
+		return num / 100 * max
+	}
+
+	return num
+}
+
+//==============================================================================
@@ -0,0 +1,110 @@
+package colors_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/influx6/govfx/colors"
+)
+
+// TestParseColor validates the parsing of the supported color formats.
+func TestParseColor(t *testing.T) {
+	expected := map[string]string{
+		"#f00":                        "rgb(255, 0, 0)",
+		"#ff000080":                   "rgba(255, 0, 0, 0.5)",
+		"#0f08":                       "rgba(0, 255, 0, 0.53)",
+		"#1E90FF":                     "rgb(30, 144, 255)",
+		"rgb(10, 20, 30)":             "rgb(10, 20, 30)",
+		"rgba(10, 20, 30, 0.25)":      "rgba(10, 20, 30, 0.25)",
+		"rgb(100% 0% 50% / 50%)":      "rgba(255, 0, 128, 0.5)",
+		"hsl(120, 100%, 50%)":         "rgb(0, 255, 0)",
+		"hsla(240deg, 100%, 50%, .4)": "rgba(0, 0, 255, 0.4)",
+		"hsl(0.5turn 100% 25%)":       "rgb(0, 128, 128)",
+		"RebeccaPurple":               "rgb(102, 51, 153)",
+		"transparent":                 "rgba(0, 0, 0, 0)",
+	}
+
+	for value, css := range expected {
+		color, err := colors.Parse(value)
+		if err != nil {
+			t.Fatalf("Should have parsed %q: %s", value, err)
+		}
+
+		if got := color.String(); got != css {
+			t.Fatalf("Should have parsed %q as %q but got %q", value, css, got)
+		}
+	}
+
+	for _, value := range []string{"#12", "rgb(1, 2)", "notacolor", "cmyk(1, 2, 3)", "rgb(a,b,c)", "hsl(x,10%,10%)", "rgb(10, 20, 30, x)", "rgb(10deg, 20, 30)"} {
+		if _, err := colors.Parse(value); err != colors.ErrInvalidColor {
+			t.Fatalf("Should have failed to parse %q", value)
+		}
+	}
+}
+
+// TestColorSpaces validates that colors round-trip through every color space.
+func TestColorSpaces(t *testing.T) {
+	spaces := []colors.Space{colors.SRGB, colors.LinearSRGB, colors.HSL, colors.Lab, colors.LCH, colors.OKLab, colors.OKLCH}
+	values := []string{"#ff0000", "#1e90ff", "rgb(12, 200, 99)", "#808080", "white", "black", "rebeccapurple"}
+
+	for _, value := range values {
+		color, _ := colors.Parse(value)
+
+		for _, space := range spaces {
+			back := colors.From(space, color.To(space), color.A)
+
+			if math.Abs(back.R-color.R) > 1e-6 || math.Abs(back.G-color.G) > 1e-6 || math.Abs(back.B-color.B) > 1e-6 {
+				t.Fatalf("Should have round-tripped %q through space %d: %+v and %+v", value, space, color, back)
+			}
+		}
+	}
+
+	white, _ := colors.Parse("white")
+	if lab := white.Lab(); math.Abs(lab[0]-100) > 1e-3 || math.Abs(lab[1]) > 1e-3 || math.Abs(lab[2]) > 1e-3 {
+		t.Fatalf("Should have white at the top of the lightness axis: %v", lab)
+	}
+
+	if oklab := white.OKLab(); math.Abs(oklab[0]-1) > 1e-3 {
+		t.Fatalf("Should have white at a OKLab lightness of 1: %v", oklab)
+	}
+
+	if _, err := colors.ParseSpace("cmyk"); err != colors.ErrInvalidSpace {
+		t.Fatal("Should have failed to parse a unknown space")
+	}
+}
+
+// TestColorMix validates the interpolation of colors in the different color
+// spaces.
+func TestColorMix(t *testing.T) {
+	red, _ := colors.Parse("red")
+	blue, _ := colors.Parse("blue")
+	gray, _ := colors.Parse("gray")
+
+	expected := map[colors.Space]string{
+		colors.SRGB:       "rgb(128, 0, 128)",
+		colors.LinearSRGB: "rgb(188, 0, 188)",
+		colors.HSL:        "rgb(255, 0, 255)",
+		colors.Lab:        "rgb(193, 0, 136)",
+		colors.OKLab:      "rgb(140, 83, 162)",
+	}
+
+	for space, css := range expected {
+		if got := colors.Mix(red, blue, 0.5, space).String(); got != css {
+			t.Fatalf("Should have mixed red and blue in space %d as %q but got %q", space, css, got)
+		}
+	}
+
+	// The hue takes the shortest path, from 0deg backwards to 240deg.
+	if hsl := colors.Mix(red, blue, 0.5, colors.HSL).HSL(); math.Abs(hsl[0]-300) > 1e-6 {
+		t.Fatalf("Should have taken the shortest hue path but got %.2f", hsl[0])
+	}
+
+	// A gray has no hue, hence the mix keeps the hue of the other color.
+	if hsl := colors.Mix(gray, blue, 0.5, colors.HSL).HSL(); math.Abs(hsl[0]-240) > 1e-6 {
+		t.Fatalf("Should have used the hue of blue but got %.2f", hsl[0])
+	}
+
+	if lch := colors.Mix(gray, red, 0.5, colors.OKLCH).OKLCH(); math.Abs(lch[2]-red.OKLCH()[2]) > 1e-3 {
+		t.Fatalf("Should have used the hue of red but got %.2f", lch[2])
+	}
+}
@@ -0,0 +1,431 @@
+package colors
+
+import (
+	"errors"
+	"math"
+	"strings"
+)
+
+//==============================================================================
+
+// ErrInvalidSpace defines the error returned when a color space name is not
+// known.
+var ErrInvalidSpace = errors.New("Invalid Color Space")
+
+// Space defines a color space in which colors are interpolated.
+type Space int
+
+// contains the supported color spaces.
+const (
+	SRGB Space = iota
+	LinearSRGB
+	HSL
+	Lab
+	LCH
+	OKLab
+	OKLCH
+)
+
+// ParseSpace returns the color space of the giving name (eg "oklch"), where an
+// empty name returns SRGB.
+func ParseSpace(name string) (Space, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "srgb", "rgb":
+		return SRGB, nil
+	case "linear-srgb", "srgb-linear":
+		return LinearSRGB, nil
+	case "hsl":
+		return HSL, nil
+	case "lab":
+		return Lab, nil
+	case "lch":
+		return LCH, nil
+	case "oklab":
+		return OKLab, nil
+	case "oklch":
+		return OKLCH, nil
+	}
+
+	return SRGB, ErrInvalidSpace
+}
+
+// hue returns the index of the hue coordinate of the space, else -1 if the
+// space has no hue.
+func (s Space) hue() int {
+	switch s {
+	case HSL:
+		return 0
+	case LCH, OKLCH:
+		return 2
+	}
+
+	return -1
+}
+
+//==============================================================================
+
+// To returns the coordinates of the color in the provided space.
+func (c Color) To(space Space) [3]float64 {
+	switch space {
+	case LinearSRGB:
+		return c.Linear()
+	case HSL:
+		return c.HSL()
+	case Lab:
+		return c.Lab()
+	case LCH:
+		return c.LCH()
+	case OKLab:
+		return c.OKLab()
+	case OKLCH:
+		return c.OKLCH()
+	}
+
+	return [3]float64{c.R, c.G, c.B}
+}
+
+// From returns the color of the coordinates in the provided space.
+func From(space Space, coords [3]float64, alpha float64) Color {
+	switch space {
+	case LinearSRGB:
+		return FromLinear(coords[0], coords[1], coords[2], alpha)
+	case HSL:
+		return FromHSL(coords[0], coords[1], coords[2], alpha)
+	case Lab:
+		return FromLab(coords[0], coords[1], coords[2], alpha)
+	case LCH:
+		return FromLCH(coords[0], coords[1], coords[2], alpha)
+	case OKLab:
+		return FromOKLab(coords[0], coords[1], coords[2], alpha)
+	case OKLCH:
+		return FromOKLCH(coords[0], coords[1], coords[2], alpha)
+	}
+
+	return Color{R: coords[0], G: coords[1], B: coords[2], A: alpha}
+}
+
+// Mix returns the mix of the two colors by the giving amount between 0 and 1,
+// interpolated in the provided space. As with css, the colors are mixed with
+// premultiplied alpha, hues take the shortest path around the color wheel and
+// a missing hue (eg of a gray) takes the hue of the other color.
+func Mix(from, to Color, amount float64, space Space) Color {
+	alpha := from.A + (to.A-from.A)*amount
+	if alpha <= 0 {
+		return Color{}
+	}
+
+	fc, tc := from.To(space), to.To(space)
+	hue := space.hue()
+
+	if hue >= 0 {
+		if powerless(space, fc) {
+			fc[hue] = tc[hue]
+		}
+
+		if powerless(space, tc) {
+			tc[hue] = fc[hue]
+		}
+
+		if diff := tc[hue] - fc[hue]; diff > 180 {
+			fc[hue] += 360
+		} else if diff < -180 {
+			tc[hue] += 360
+		}
+	}
+
+	var mixed [3]float64
+
+	for ind := 0; ind < 3; ind++ {
+		if ind == hue {
+			mixed[ind] = math.Mod(fc[ind]+(tc[ind]-fc[ind])*amount, 360)
+			continue
+		}
+
+		pa, pb := fc[ind]*from.A, tc[ind]*to.A
+		mixed[ind] = (pa + (pb-pa)*amount) / alpha
+	}
+
+	return From(space, mixed, alpha)
+}
+
+// powerless returns true/false if the hue of the coordinates has no effect on
+// the color, as for grays.
+func powerless(space Space, coords [3]float64) bool {
+	switch space {
+	case HSL:
+		return coords[1] < 1e-6
+	case LCH:
+		return coords[1] < 1e-4
+	case OKLCH:
+		return coords[1] < 1e-6
+	}
+
+	return false
+}
+
+//==============================================================================
+
+// Linear returns the linear-sRGB coordinates of the color.
+func (c Color) Linear() [3]float64 {
+	return [3]float64{toLinear(c.R), toLinear(c.G), toLinear(c.B)}
+}
+
+// FromLinear returns the color of the linear-sRGB coordinates.
+func FromLinear(r, g, b float64, alpha float64) Color {
+	return Color{R: fromLinear(r), G: fromLinear(g), B: fromLinear(b), A: alpha}
+}
+
+// toLinear removes the gamma encoding of a sRGB channel.
+func toLinear(value float64) float64 {
+	abs := math.Abs(value)
+	if abs <= 0.04045 {
+		return value / 12.92
+	}
+
+	return math.Copysign(math.Pow((abs+0.055)/1.055, 2.4), value)
+}
+
+// fromLinear applies the gamma encoding of a sRGB channel.
+func fromLinear(value float64) float64 {
+	abs := math.Abs(value)
+	if abs <= 0.0031308 {
+		return value * 12.92
+	}
+
+	return math.Copysign(1.055*math.Pow(abs, 1/2.4)-0.055, value)
+}
+
+//==============================================================================
+
+// HSL returns the hue in degrees and the saturation and lightness between 0
+// and 1 of the color.
+func (c Color) HSL() [3]float64 {
+	max := math.Max(c.R, math.Max(c.G, c.B))
+	min := math.Min(c.R, math.Min(c.G, c.B))
+
+	lightness := (max + min) / 2
+	delta := max - min
+
+	if delta == 0 {
+		return [3]float64{0, 0, lightness}
+	}
+
+	saturation := delta / (1 - math.Abs(2*lightness-1))
+
+	var hue float64
+
+	switch max {
+	case c.R:
+		hue = math.Mod((c.G-c.B)/delta, 6)
+	case c.G:
+		hue = (c.B-c.R)/delta + 2
+	default:
+		hue = (c.R-c.G)/delta + 4
+	}
+
+	hue *= 60
+	if hue < 0 {
+		hue += 360
+	}
+
+	return [3]float64{hue, saturation, lightness}
+}
+
+// FromHSL returns the color of the hue in degrees and the saturation and
+// lightness between 0 and 1.
+func FromHSL(hue, saturation, lightness float64, alpha float64) Color {
+	hue = math.Mod(hue, 360)
+	if hue < 0 {
+		hue += 360
+	}
+
+	saturation = clamp(saturation)
+	lightness = clamp(lightness)
+
+	fn := func(n float64) float64 {
+		k := math.Mod(n+hue/30, 12)
+		a := saturation * math.Min(lightness, 1-lightness)
+		return lightness - a*math.Max(-1, math.Min(k-3, math.Min(9-k, 1)))
+	}
+
+	return Color{R: fn(0), G: fn(8), B: fn(4), A: alpha}
+}
+
+//==============================================================================
+
+// contains the constants of the Lab color space.
+const (
+	labEpsilon = 216.0 / 24389
+	labKappa   = 24389.0 / 27
+)
+
+// d50White defines the D50 reference white used by the Lab color space.
+var d50White = [3]float64{0.3457 / 0.3585, 1, (1 - 0.3457 - 0.3585) / 0.3585}
+
+// contains the matrices converting between linear-sRGB, the D65 XYZ space and
+// the D50 XYZ space used by Lab.
+var (
+	linearToXYZ = [3][3]float64{
+		{0.41239079926595934, 0.357584339383878, 0.1804807884018343},
+		{0.21263900587151027, 0.715168678767756, 0.07219231536073371},
+		{0.01933081871559182, 0.11919477979462598, 0.9505321522496607},
+	}
+
+	xyzToLinear = [3][3]float64{
+		{3.2409699419045226, -1.537383177570094, -0.4986107602930034},
+		{-0.9692436362808796, 1.8759675015077202, 0.04155505740717559},
+		{0.05563007969699366, -0.20397695888897652, 1.0569715142428786},
+	}
+
+	d65ToD50 = [3][3]float64{
+		{1.0479297925449969, 0.022946870601609652, -0.05019226628920524},
+		{0.02962780877005599, 0.9904344267538799, -0.017073799063418826},
+		{-0.009243040646204504, 0.015055191490298152, 0.7518742814281371},
+	}
+
+	d50ToD65 = [3][3]float64{
+		{0.955473421488075, -0.02309845494876471, 0.06325924320057072},
+		{-0.0283697093338637, 1.0099953980813041, 0.021041441191917323},
+		{0.012314014864481998, -0.020507649298898964, 1.330365926242124},
+	}
+)
+
+// Lab returns the CIE Lab coordinates of the color, with the lightness between
+// 0 and 100.
+func (c Color) Lab() [3]float64 {
+	xyz := multiply(d65ToD50, multiply(linearToXYZ, c.Linear()))
+
+	var f [3]float64
+	for ind := range xyz {
+		value := xyz[ind] / d50White[ind]
+
+		if value > labEpsilon {
+			f[ind] = math.Cbrt(value)
+		} else {
+			f[ind] = (labKappa*value + 16) / 116
+		}
+	}
+
+	return [3]float64{116*f[1] - 16, 500 * (f[0] - f[1]), 200 * (f[1] - f[2])}
+}
+
+// FromLab returns the color of the CIE Lab coordinates.
+func FromLab(lightness, a, b float64, alpha float64) Color {
+	f1 := (lightness + 16) / 116
+	f0 := a/500 + f1
+	f2 := f1 - b/200
+
+	var xyz [3]float64
+
+	if cube := f0 * f0 * f0; cube > labEpsilon {
+		xyz[0] = cube
+	} else {
+		xyz[0] = (116*f0 - 16) / labKappa
+	}
+
+	if lightness > labKappa*labEpsilon {
+		xyz[1] = f1 * f1 * f1
+	} else {
+		xyz[1] = lightness / labKappa
+	}
+
+	if cube := f2 * f2 * f2; cube > labEpsilon {
+		xyz[2] = cube
+	} else {
+		xyz[2] = (116*f2 - 16) / labKappa
+	}
+
+	for ind := range xyz {
+		xyz[ind] *= d50White[ind]
+	}
+
+	linear := multiply(xyzToLinear, multiply(d50ToD65, xyz))
+	return FromLinear(linear[0], linear[1], linear[2], alpha)
+}
+
+// LCH returns the CIE LCH coordinates of the color, with the hue in degrees.
+func (c Color) LCH() [3]float64 {
+	return toPolar(c.Lab())
+}
+
+// FromLCH returns the color of the CIE LCH coordinates.
+func FromLCH(lightness, chroma, hue float64, alpha float64) Color {
+	lab := fromPolar([3]float64{lightness, chroma, hue})
+	return FromLab(lab[0], lab[1], lab[2], alpha)
+}
+
+//==============================================================================
+
+// OKLab returns the OKLab coordinates of the color, with the lightness between
+// 0 and 1.
+func (c Color) OKLab() [3]float64 {
+	lin := c.Linear()
+
+	l := math.Cbrt(0.4122214708*lin[0] + 0.5363325363*lin[1] + 0.0514459929*lin[2])
+	m := math.Cbrt(0.2119034982*lin[0] + 0.6806995451*lin[1] + 0.1073969566*lin[2])
+	s := math.Cbrt(0.0883024619*lin[0] + 0.2817188376*lin[1] + 0.6299787005*lin[2])
+
+	return [3]float64{
+		0.2104542553*l + 0.7936177850*m - 0.0040720468*s,
+		1.9779984951*l - 2.4285922050*m + 0.4505937099*s,
+		0.0259040371*l + 0.7827717662*m - 0.8086757660*s,
+	}
+}
+
+// FromOKLab returns the color of the OKLab coordinates.
+func FromOKLab(lightness, a, b float64, alpha float64) Color {
+	l := lightness + 0.3963377774*a + 0.2158037573*b
+	m := lightness - 0.1055613458*a - 0.0638541728*b
+	s := lightness - 0.0894841775*a - 1.2914855480*b
+
+	l, m, s = l*l*l, m*m*m, s*s*s
+
+	return FromLinear(
+		4.0767416621*l-3.3077115913*m+0.2309699292*s,
+		-1.2684380046*l+2.6097574011*m-0.3413193965*s,
+		-0.0041960863*l-0.7034186147*m+1.7076147010*s,
+		alpha,
+	)
+}
+
+// OKLCH returns the OKLCH coordinates of the color, with the hue in degrees.
+func (c Color) OKLCH() [3]float64 {
+	return toPolar(c.OKLab())
+}
+
+// FromOKLCH returns the color of the OKLCH coordinates.
+func FromOKLCH(lightness, chroma, hue float64, alpha float64) Color {
+	lab := fromPolar([3]float64{lightness, chroma, hue})
+	return FromOKLab(lab[0], lab[1], lab[2], alpha)
+}
+
+//==============================================================================
+
+// multiply returns the vector multiplied by the matrix.
+func multiply(m [3][3]float64, v [3]float64) [3]float64 {
+	return [3]float64{
+		m[0][0]*v[0] + m[0][1]*v[1] + m[0][2]*v[2],
+		m[1][0]*v[0] + m[1][1]*v[1] + m[1][2]*v[2],
+		m[2][0]*v[0] + m[2][1]*v[1] + m[2][2]*v[2],
+	}
+}
+
+// toPolar returns the lightness, chroma and hue in degrees of the lightness, a
+// and b coordinates.
+func toPolar(lab [3]float64) [3]float64 {
+	hue := math.Atan2(lab[2], lab[1]) * 180 / math.Pi
+	if hue < 0 {
+		hue += 360
+	}
+
+	return [3]float64{lab[0], math.Hypot(lab[1], lab[2]), hue}
+}
+
+// fromPolar returns the lightness, a and b coordinates of the lightness, chroma
+// and hue in degrees.
+func fromPolar(lch [3]float64) [3]float64 {
+	rad := lch[2] * math.Pi / 180
+	return [3]float64{lch[0], lch[1] * math.Cos(rad), lch[1] * math.Sin(rad)}
+}
+
+//==============================================================================
@@ -0,0 +1,44 @@
+package govfx_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influx6/govfx"
+)
+
+// TestColorAnimators validates the interpolation of the color animators.
+func TestColorAnimators(t *testing.T) {
+	doc := newDocument(1)
+	doc.AddRule(".item", "background-color: #ff0000; color: white")
+
+	items := govfx.QuerySelectorAll(".item")
+	timeline, err := govfx.Animate(govfx.Stat{
+		Duration: 1 * time.Second,
+		Clock:    govfx.NewManualClock(time.Unix(0, 0)),
+	}, govfx.Values{
+		{"color": "rgba(0, 0, 255, 0.5)", "animate": "background-color", "easing": "linear"},
+		{"color": "black", "animate": "color", "easing": "linear"},
+		{"color": "hsl(120, 100%, 25%)", "animate": "border-color", "easing": "linear"},
+		{"color": "blue", "animate": "outline-color", "easing": "linear", "space": "hsl"},
+	}, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timeline.SeekProgress(0.5)
+
+	// The alpha is premultiplied while mixing, hence the more opaque red keeps
+	// a larger share of the mixed color.
+	expected := "background-color: rgba(170, 0, 85, 0.75); color: rgb(128, 128, 128); border-color: rgb(128, 191, 128); outline-color: rgb(159, 159, 223)"
+	if style := items[0].GetAttribute("style"); style != expected {
+		t.Fatalf("Should have interpolated the colors:\n%q\n%q", style, expected)
+	}
+
+	timeline.SeekProgress(1)
+
+	expected = "background-color: rgba(0, 0, 255, 0.5); color: rgb(0, 0, 0); border-color: rgb(0, 128, 0); outline-color: rgb(0, 0, 255)"
+	if style := items[0].GetAttribute("style"); style != expected {
+		t.Fatalf("Should have reached the target colors:\n%q\n%q", style, expected)
+	}
+}
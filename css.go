@@ -112,22 +112,53 @@ func GetComputedStyleMap(elem dom.Element, ps string) (ComputedStyleMap, error)
 			unvendoredName = strings.TrimPrefix(unvendoredName, fmt.Sprintf("-%s-", vo))
 		}
 
-		var vals []string
+		styleMap[unvendoredName] = newComputedStyle(unvendoredName, key, val, priority > 0)
+	}
 
-		if strings.TrimSpace(val) != "none" {
-			vals = append(vals, val)
-		}
+	return styleMap, nil
+}
+
+// GetComputedStyleProperty returns the computed style of the single property,
+// falling back to its vendored names if the browser does not know it, which
+// avoids reading every computed property of the element as
+// GetComputedStyleMap does.
+func GetComputedStyleProperty(elem dom.Element, ps string, prop string) (*ComputedStyle, error) {
+	css, err := GetComputedStyle(elem, ps)
+	if err != nil {
+		return nil, err
+	}
 
-		styleMap[unvendoredName] = &ComputedStyle{
-			Name:       unvendoredName,
-			VendorName: key,
-			Value:      val,
-			Values:     vals,
-			Priority:   (priority > 0),
+	for _, name := range append([]string{prop}, Vendorize(prop)...) {
+		val := css.GetPropertyValue(name)
+		if val == "" {
+			continue
 		}
+
+		priority, _ := GetComputedStylePriority(css, name)
+		return newComputedStyle(prop, name, val, priority > 0), nil
 	}
 
-	return styleMap, nil
+	return nil, ErrNotFound
+}
+
+// newComputedStyle returns a new ComputedStyle for the property value, where
+// a value of "none" has no values.
+func newComputedStyle(name string, vendorName string, value string, priority bool) *ComputedStyle {
+	var vals []string
+
+	if strings.TrimSpace(value) != "none" {
+		vals = append(vals, value)
+	}
+
+	cs := ComputedStyle{
+		Name:       name,
+		VendorName: vendorName,
+		Value:      value,
+		Values:     vals,
+		Priority:   priority,
+	}
+
+	return &cs
 }
 
 // Has returns true/false if the property exists.
@@ -173,63 +204,6 @@ func IsRGBA(c string) bool {
 	return rgbaHeader.MatchString(c)
 }
 
-// ParseRGB pulls out the rgb/rgba information from a rgba(9,9,9,9) type
-// formatted string.
-func ParseRGB(rgbData string) (int, int, int, float64) {
-	subs := colorReg.FindStringSubmatch(rgbData)
-
-	if len(subs) < 2 {
-		return 0, 0, 0, 0
-	}
-
-	rc := strings.Split(subs[1], ",")
-
-	var r, g, b int
-	var alpha float64
-
-	r = ParseInt(rc[0])
-	g = ParseInt(rc[1])
-	b = ParseInt(rc[2])
-
-	if len(rc) > 3 {
-		alpha = ParseFloat(rc[3])
-	} else {
-		alpha = 1
-	}
-
-	return r, g, b, alpha
-}
-
-// HexToRGB turns a hexademicmal color into rgba format.
-// Returns the read, green and blue values as int.
-func HexToRGB(hex string) (red, green, blue int) {
-	if strings.HasPrefix(hex, "#") {
-		hex = strings.TrimPrefix(hex, "#")
-	}
-
-	// We are dealing with a 3 string hex.
-	if len(hex) < 6 {
-		parts := strings.Split(hex, "")
-		red = ParseIntBase16(doubleString(parts[0]))
-		green = ParseIntBase16(doubleString(parts[1]))
-		blue = ParseIntBase16(doubleString(parts[2]))
-		return
-	}
-
-	red = ParseIntBase16(hex[0:2])
-	green = ParseIntBase16(hex[2:4])
-	blue = ParseIntBase16(hex[4:6])
-
-	return
-}
-
-// HexToRGBA turns a hexademicmal color into rgba format.
-// Alpha values ranges from 0-100
-func HexToRGBA(hex string, alpha int) string {
-	r, g, b := HexToRGB(hex)
-	return fmt.Sprintf("rgba(%d,%d,%d,%.2f)", r, g, b, float64(alpha)/100)
-}
-
 // vendorTags provides a lists of different browser specific vendor names.
 var vendorTags = []string{"moz", "webki", "O", "ms"}
 
@@ -245,28 +219,15 @@ func Vendorize(u string) []string {
 	return v
 }
 
-// Unit returns a valid unit type in the browser, if the supplied unit is
-// standard then it is return else 'px' is returned as default.
-func Unit(u string) string {
-	switch u {
-	case "rem":
-		return u
-	case "em":
-		return u
-	case "px":
-		return u
-	case "%":
-		return u
-	case "vw":
-		return u
-	default:
-		return "px"
+// Unit returns the supplied unit lowercased as used by the browser, along
+// with false if it is not a known css length unit, in which case the unit is
+// left to the caller to reject or replace.
+func Unit(u string) (string, bool) {
+	if !IsLengthUnit(u) {
+		return "", false
 	}
-}
 
-// doubleString doubles the giving string.
-func doubleString(c string) string {
-	return fmt.Sprintf("%s%s", c, c)
+	return strings.ToLower(u), true
 }
 
 //==============================================================================
@@ -457,7 +418,7 @@ func ToTranslation(data string) (*Translation, error) {
 
 //==============================================================================
 
-var matrixMatch = regexp.MustCompile("matrix(3[d|D])?\\(([,\\d\\s]+)\\)")
+var matrixMatch = regexp.MustCompile("matrix(3[dD])?\\(([-+.eE,\\d\\s]+)\\)")
 
 // IsMatrix returns true/false if the giving string is a matrix declaration.
 func IsMatrix(data string) bool {
@@ -465,7 +426,7 @@ func IsMatrix(data string) bool {
 		return false
 	}
 
-	ms := strings.Split(matrixMatch.FindStringSubmatch(data)[1], ",")
+	ms := strings.Split(matrixMatch.FindStringSubmatch(data)[2], ",")
 
 	if len(ms) < 6 {
 		return false
@@ -474,7 +435,10 @@ func IsMatrix(data string) bool {
 	return true
 }
 
-// Matrix defines a transformation matrix generated from a transform directive.
+// Matrix defines the 2D components of a transformation matrix generated from a
+// transform directive, where ScaleX, RotationX, ScaleY, RotationY, PositionX
+// and PositionY hold the a, b, c, d, e and f values of matrix(a,b,c,d,e,f).
+// Use ParseMatrix and Matrix3D.Decompose for the real components.
 type Matrix struct {
 	ScaleX    float64
 	RotationX float64
@@ -494,23 +458,84 @@ func ToMatrix2D(data string) (*Matrix, error) {
 		return nil, errors.New("Invalid Matrix data")
 	}
 
-	ms := strings.Split(matrixMatch.FindStringSubmatch(data)[1], ",")
-
-	if len(ms) < 6 {
+	mx, err := ParseMatrix(matrixMatch.FindString(data))
+	if err != nil {
 		return nil, errors.New("Invalid Matrix data")
 	}
 
 	m := Matrix{
-		ScaleX:    ParseFloat(ms[0]),
-		RotationX: ParseFloat(ms[1]),
-		ScaleY:    ParseFloat(ms[2]),
-		RotationY: ParseFloat(ms[3]),
-		PositionX: ParseFloat(ms[4]),
-		PositionY: ParseFloat(ms[5]),
+		ScaleX:    mx[0][0],
+		RotationX: mx[0][1],
+		ScaleY:    mx[1][0],
+		RotationY: mx[1][1],
+		PositionX: mx[3][0],
+		PositionY: mx[3][1],
+		PositionZ: mx[3][2],
+		ScaleZ:    mx[2][2],
 	}
 
 	return &m, nil
 }
 
-// type Matrix3D [3]*Matrix2D
+//==============================================================================
+
+// styleDecl defines a single property declaration read from a css text.
+type styleDecl struct {
+	Property string
+	Value    string
+	Priority bool
+}
+
+// parseStyleText parses a css declaration text (eg the style attribute of an
+// element) into its list of declarations in the order they appear.
+func parseStyleText(text string) []styleDecl {
+	var decls []styleDecl
+
+	for _, item := range strings.Split(text, ";") {
+		parts := strings.SplitN(item, ":", 2)
+		if len(parts) < 2 {
+			continue
+		}
+
+		prop := strings.ToLower(strings.TrimSpace(parts[0]))
+		value := strings.TrimSpace(parts[1])
+
+		if prop == "" {
+			continue
+		}
+
+		var priority bool
+
+		if strings.HasSuffix(value, "!important") {
+			priority = true
+			value = strings.TrimSpace(strings.TrimSuffix(value, "!important"))
+		}
+
+		decls = append(decls, styleDecl{
+			Property: prop,
+			Value:    value,
+			Priority: priority,
+		})
+	}
+
+	return decls
+}
+
+// formatStyleText returns the css declaration text of the declarations in
+// their order, as written to the style attribute of an element.
+func formatStyleText(decls []styleDecl) string {
+	items := make([]string, 0, len(decls))
+
+	for _, decl := range decls {
+		item := decl.Property + ": " + decl.Value
+		if decl.Priority {
+			item += " !important"
+		}
+
+		items = append(items, item)
+	}
+
+	return strings.Join(items, "; ")
+}
+
 //==============================================================================
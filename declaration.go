@@ -0,0 +1,136 @@
+package govfx
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+	"strings"
+)
+
+//==============================================================================
+
+// Declarer defines a Sequence which emits its state as structured
+// declarations instead of formatting css text, allowing the declarations of
+// every sequence of a element to be combined into a single block.
+type Declarer interface {
+	Declare(*Declarations)
+}
+
+// DeclarationsOf returns the declarations of the css element, emitted by it if
+// it is a Declarer else parsed from its css output.
+func DeclarationsOf(elem CSSElem) Declarations {
+	var decls Declarations
+
+	if dc, ok := elem.(Declarer); ok {
+		dc.Declare(&decls)
+		return decls
+	}
+
+	var buf bytes.Buffer
+	elem.CSS(&buf)
+
+	for _, decl := range ParseDeclarations(buf.String()) {
+		decls.Set(decl)
+	}
+
+	return decls
+}
+
+//==============================================================================
+
+// Declaration defines a single css property declaration, where the value is
+// written followed by its unit (eg a Value of "10" with a Unit of "px" for
+// 10px) and Important adds the !important priority.
+type Declaration struct {
+	Property  string
+	Value     string
+	Unit      string
+	Important bool
+}
+
+// CSSValue returns the css value of the declaration with its unit.
+func (d Declaration) CSSValue() string {
+	return d.Value + d.Unit
+}
+
+// String returns the declaration in css format (eg "width: 10px").
+func (d Declaration) String() string {
+	if d.Important {
+		return d.Property + ": " + d.CSSValue() + " !important"
+	}
+
+	return d.Property + ": " + d.CSSValue()
+}
+
+//==============================================================================
+
+// declarationUnit matches a number followed by its unit.
+var declarationUnit = regexp.MustCompile(`^([-+]?(?:\d+\.?\d*|\.\d+)(?:e[-+]?\d+)?)([a-z%]*)$`)
+
+// Declarations defines a block of declarations in the order their properties
+// were first set, where each property appears once.
+type Declarations []Declaration
+
+// ParseDeclarations parses a css declaration text (eg "width: 10px; opacity:
+// 0.5") into its declarations, splitting the unit from numeric values.
+func ParseDeclarations(text string) Declarations {
+	var decls Declarations
+
+	for _, item := range parseStyleText(text) {
+		decl := Declaration{
+			Property:  item.Property,
+			Value:     item.Value,
+			Important: item.Priority,
+		}
+
+		if parts := declarationUnit.FindStringSubmatch(strings.ToLower(item.Value)); parts != nil {
+			decl.Value, decl.Unit = parts[1], parts[2]
+		}
+
+		decls.Set(decl)
+	}
+
+	return decls
+}
+
+// Set sets the declaration, replacing the declaration of the same property in
+// place if it exists else adding it to the end of the block.
+func (d *Declarations) Set(decl Declaration) {
+	for ind := range *d {
+		if (*d)[ind].Property == decl.Property {
+			(*d)[ind] = decl
+			return
+		}
+	}
+
+	*d = append(*d, decl)
+}
+
+// Get returns the declaration of the property, else false if it has none.
+func (d Declarations) Get(prop string) (Declaration, bool) {
+	for _, decl := range d {
+		if decl.Property == prop {
+			return decl, true
+		}
+	}
+
+	return Declaration{}, false
+}
+
+// String returns the block in css format (eg "width: 10px; opacity: 0.5").
+func (d Declarations) String() string {
+	items := make([]string, 0, len(d))
+
+	for _, decl := range d {
+		items = append(items, decl.String())
+	}
+
+	return strings.Join(items, "; ")
+}
+
+// CSS implements the CSSElem interface and writes the block in css format.
+func (d Declarations) CSS(w io.Writer) {
+	io.WriteString(w, d.String())
+}
+
+//==============================================================================
@@ -0,0 +1,81 @@
+package govfx_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influx6/govfx"
+)
+
+// TestDeclarations validates the parsing, merging and serializing of
+// declaration blocks.
+func TestDeclarations(t *testing.T) {
+	decls := govfx.ParseDeclarations("width: 10px; color: rgb(1, 2, 3); opacity: .5 !important; width: 2.5e1%")
+
+	expected := []govfx.Declaration{
+		{Property: "width", Value: "2.5e1", Unit: "%"},
+		{Property: "color", Value: "rgb(1, 2, 3)"},
+		{Property: "opacity", Value: ".5", Important: true},
+	}
+
+	if len(decls) != len(expected) {
+		t.Fatalf("Should have parsed %d declarations but got %d", len(expected), len(decls))
+	}
+
+	for ind, decl := range expected {
+		if decls[ind] != decl {
+			t.Fatalf("Should have parsed %+v but got %+v", decl, decls[ind])
+		}
+	}
+
+	decls.Set(govfx.Declaration{Property: "color", Value: "red"})
+	decls.Set(govfx.Declaration{Property: "height", Value: "4", Unit: "em"})
+
+	text := "width: 2.5e1%; color: red; opacity: .5 !important; height: 4em"
+	if got := decls.String(); got != text {
+		t.Fatalf("Should have serialized the declarations:\n%q\n%q", got, text)
+	}
+
+	if decl, ok := decls.Get("height"); !ok || decl.CSSValue() != "4em" {
+		t.Fatalf("Should have found the height declaration but got %+v", decl)
+	}
+}
+
+// TestElementDeclarations validates that all sequences of a element combine
+// into a single valid declaration block.
+func TestElementDeclarations(t *testing.T) {
+	newDocument(1)
+
+	items := govfx.QuerySelectorAll(".item")
+	timeline, err := govfx.Animate(govfx.Stat{
+		Duration: 1 * time.Second,
+		Clock:    govfx.NewManualClock(time.Unix(0, 0)),
+	}, govfx.Values{
+		{"value": 20, "animate": "width", "easing": "linear"},
+		{"value": 30, "animate": "translate-x", "easing": "linear"},
+		{"value": 0.5, "animate": "opacity", "easing": "linear"},
+		{"value": "5rem", "animate": "width", "easing": "linear"},
+		{"value": 45, "animate": "rotate", "easing": "linear"},
+		{"value": 10, "animate": "test-offset", "easing": "linear"},
+	}, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timeline.SeekProgress(1)
+
+	decls := items[0].Declarations()
+
+	expected := "width: 5rem; opacity: 0.5; left: 10px; transform: translateX(30px) rotate(45deg)"
+	if got := decls.String(); got != expected {
+		t.Fatalf("Should have combined the sequences into one block:\n%q\n%q", got, expected)
+	}
+
+	if decl, _ := decls.Get("width"); decl.Value != "5" || decl.Unit != "rem" {
+		t.Fatalf("Should have emitted the width with its unit but got %+v", decl)
+	}
+
+	if style := items[0].GetAttribute("style"); style != expected {
+		t.Fatalf("Should have written the block into the style:\n%q\n%q", style, expected)
+	}
+}
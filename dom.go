@@ -41,7 +41,7 @@ func Document() dom.Document {
 func QuerySelectorAll(selector string) Elementals {
 	var eml Elementals
 
-	items := Document().QuerySelectorAll(selector)
+	items := GetBackend().QuerySelectorAll(selector)
 
 	for _, item := range items {
 		eml = append(eml, NewElement(item, ""))
@@ -53,7 +53,7 @@ func QuerySelectorAll(selector string) Elementals {
 // QuerySelector returns the elemental that maches the selector else returns
 // nil.
 func QuerySelector(selector string) Elemental {
-	node := Document().QuerySelector(selector)
+	node := GetBackend().QuerySelector(selector)
 	if node == nil {
 		return nil
 	}
@@ -62,17 +62,17 @@ func QuerySelector(selector string) Elemental {
 }
 
 // TransformElements returns a lists of Elementals if the argument provided
-// is either a dom.Element, a list of dom.Element or Elementals, transforming
-// them accordingly else returns nil.
+// is either a DOMElement, a list of DOMElement, dom.Element or Elementals,
+// transforming them accordingly else returns nil.
 func TransformElements(elem interface{}) Elementals {
 
 	switch elem.(type) {
 	case Elementals:
 		return elem.(Elementals)
-	case Element:
+	case Elemental:
 		return Elementals{elem.(Elemental)}
-	case dom.Element:
-		return Elementals{NewElement(elem.(dom.Element), "")}
+	case DOMElement:
+		return Elementals{NewElement(elem.(DOMElement), "")}
 	case []dom.Element:
 		var m Elementals
 
@@ -82,6 +82,16 @@ func TransformElements(elem interface{}) Elementals {
 			}
 		}
 
+		return m
+	case []DOMElement:
+		var m Elementals
+
+		for _, item := range elem.([]DOMElement) {
+			if item != nil {
+				m = append(m, NewElement(item, ""))
+			}
+		}
+
 		return m
 	}
 
@@ -258,9 +268,9 @@ func Offset(elem dom.Element) (float64, float64) {
 	return top, left
 }
 
-// BoundingBox returns the top,right,down,left corners of a dom.Element.
-func BoundingBox(elem dom.Element) (float64, float64, float64, float64) {
-	rect := elem.GetBoundingClientRect()
+// BoundingBox returns the top,right,down,left corners of a DOMElement.
+func BoundingBox(elem DOMElement) (float64, float64, float64, float64) {
+	rect := GetBackend().BoundingRect(elem)
 	return rect.Top, rect.Right, rect.Bottom, rect.Left
 }
 
@@ -293,7 +303,11 @@ func OffsetParent(elem dom.Element) *js.Object {
 func init() {
 	if detect.IsBrowser() {
 		initScrollProperties()
+		UseBackend(BrowserBackend())
+		return
 	}
+
+	UseBackend(NewHeadless())
 }
 
 //==============================================================================
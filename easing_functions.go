@@ -1,13 +1,18 @@
 package govfx
 
+import "math"
+
 //==============================================================================
 
+// easeIn defines the curve of the css 'ease-in' easing.
+var easeIn = NewSpline(0.42, 0, 1, 1)
+
 // EaseIn provides a struct for 'easing-in' based animation.
 type EaseIn struct{}
 
 // Ease returns a new value base on the EaseConfig received.
 func (e EaseIn) Ease(d float64) float64 {
-	return d
+	return easeIn.Ease(d)
 }
 
 //==============================================================================
@@ -21,3 +26,185 @@ func (e EaseInQuad) Ease(ms float64) float64 {
 }
 
 //==============================================================================
+
+// EasingFunc defines a function which implements the Easing interface.
+type EasingFunc func(float64) float64
+
+// Ease implements the Easing interface.
+func (e EasingFunc) Ease(progress float64) float64 {
+	return e(progress)
+}
+
+//==============================================================================
+
+// DefaultOvershoot defines the overshoot of the back easings.
+const DefaultOvershoot = 1.70158
+
+// Back provides the 'ease-in-back' easing which pulls back by the overshoot
+// before moving to its target.
+type Back struct {
+	Overshoot float64
+}
+
+// Ease implements the Easing interface.
+func (b Back) Ease(t float64) float64 {
+	return t * t * ((b.Overshoot+1)*t - b.Overshoot)
+}
+
+//==============================================================================
+
+// Elastic provides the 'ease-in-elastic' easing which oscillates with the
+// amplitude and period before moving to its target.
+type Elastic struct {
+	Amplitude float64
+	Period    float64
+}
+
+// Ease implements the Easing interface.
+func (e Elastic) Ease(t float64) float64 {
+	if t == 0 || t == 1 {
+		return t
+	}
+
+	amplitude, shift := e.Amplitude, e.Period/4
+	if amplitude >= 1 {
+		shift = e.Period / (2 * math.Pi) * math.Asin(1/amplitude)
+	} else {
+		amplitude = 1
+	}
+
+	t--
+	return -(amplitude * math.Pow(2, 10*t) * math.Sin((t-shift)*(2*math.Pi)/e.Period))
+}
+
+//==============================================================================
+
+// Bounce provides the 'ease-in-bounce' easing which bounces with decreasing
+// height before moving to its target.
+type Bounce struct{}
+
+// Ease implements the Easing interface.
+func (b Bounce) Ease(t float64) float64 {
+	return 1 - bounceOut(1-t)
+}
+
+// bounceOut returns the value of the 'ease-out-bounce' easing.
+func bounceOut(t float64) float64 {
+	switch {
+	case t < 1/2.75:
+		return 7.5625 * t * t
+	case t < 2/2.75:
+		t -= 1.5 / 2.75
+		return 7.5625*t*t + 0.75
+	case t < 2.5/2.75:
+		t -= 2.25 / 2.75
+		return 7.5625*t*t + 0.9375
+	}
+
+	t -= 2.625 / 2.75
+	return 7.5625*t*t + 0.984375
+}
+
+//==============================================================================
+
+// PennerEasings defines the 'ease-in' forms of the Robert Penner easings keyed
+// by their family. Their 'ease-out', 'ease-in-out' and 'ease-out-in' forms
+// are derived using ReverseEasing, MirrorEasing and ChainEasing.
+var PennerEasings = map[string]Easing{
+	"quad":  EaseInQuad{},
+	"cubic": EasingFunc(func(t float64) float64 { return t * t * t }),
+	"quart": EasingFunc(func(t float64) float64 { return t * t * t * t }),
+	"quint": EasingFunc(func(t float64) float64 { return t * t * t * t * t }),
+	"sine": EasingFunc(func(t float64) float64 {
+		return 1 - math.Cos(t*math.Pi/2)
+	}),
+	"expo": EasingFunc(func(t float64) float64 {
+		if t == 0 {
+			return 0
+		}
+
+		return math.Pow(2, 10*(t-1))
+	}),
+	"circ": EasingFunc(func(t float64) float64 {
+		return 1 - math.Sqrt(1-t*t)
+	}),
+	"back":    Back{Overshoot: DefaultOvershoot},
+	"elastic": Elastic{Amplitude: 1, Period: 0.3},
+	"bounce":  Bounce{},
+}
+
+// pennerInOut defines the 'ease-in' forms used for the 'ease-in-out' forms of
+// the families which Robert Penner widens for them.
+var pennerInOut = map[string]Easing{
+	"back":    Back{Overshoot: DefaultOvershoot * 1.525},
+	"elastic": Elastic{Amplitude: 1, Period: 0.45},
+}
+
+// registerPennerEasings registers the 'ease-in', 'ease-out', 'ease-in-out' and
+// 'ease-out-in' forms of the PennerEasings, eg 'ease-in-out-bounce'.
+func registerPennerEasings() {
+	for family, in := range PennerEasings {
+		inOut, ok := pennerInOut[family]
+		if !ok {
+			inOut = in
+		}
+
+		RegisterEasing("ease-in-"+family, in)
+		RegisterEasing("ease-out-"+family, ReverseEasing(in))
+		RegisterEasing("ease-in-out-"+family, MirrorEasing(inOut))
+		RegisterEasing("ease-out-in-"+family, ChainEasing(ReverseEasing(in), in))
+	}
+}
+
+//==============================================================================
+
+// ReverseEasing returns a easing which plays the provided easing backwards,
+// turning a 'ease-in' easing into its 'ease-out' form and the reverse.
+func ReverseEasing(easing Easing) Easing {
+	return EasingFunc(func(t float64) float64 {
+		return 1 - easing.Ease(1-t)
+	})
+}
+
+// MirrorEasing returns a easing which runs the provided easing for the first
+// half and its reverse for the second half, turning a 'ease-in' easing into
+// its 'ease-in-out' form.
+func MirrorEasing(easing Easing) Easing {
+	return EasingFunc(func(t float64) float64 {
+		if t < 0.5 {
+			return easing.Ease(2*t) / 2
+		}
+
+		return 1 - easing.Ease(2-2*t)/2
+	})
+}
+
+// ChainEasing returns a easing which runs the provided easings one after the
+// other, each over an equal share of the progress and distance.
+func ChainEasing(easings ...Easing) Easing {
+	return EasingFunc(func(t float64) float64 {
+		total := float64(len(easings))
+
+		index := int(t * total)
+		if index < 0 {
+			index = 0
+		}
+
+		if index >= len(easings) {
+			index = len(easings) - 1
+		}
+
+		local := t*total - float64(index)
+		return (float64(index) + easings[index].Ease(local)) / total
+	})
+}
+
+// BlendEasing returns a easing which blends the values of the provided
+// easings by the amount, where 0 returns the first easing and 1 the second.
+func BlendEasing(from Easing, to Easing, amount float64) Easing {
+	return EasingFunc(func(t float64) float64 {
+		return Lerp(from.Ease(t), to.Ease(t), amount)
+	})
+}
+
+//==============================================================================
@@ -0,0 +1,383 @@
+package govfx
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+//==============================================================================
+
+// EasingError defines the error returned when a easing value can not be
+// parsed, providing the value with the reason it is invalid.
+type EasingError struct {
+	Value  string
+	Reason string
+}
+
+// Error implements the error interface.
+func (e *EasingError) Error() string {
+	return fmt.Sprintf("Invalid Easing[%s]: %s", e.Value, e.Reason)
+}
+
+// easingErr returns a new EasingError for the value.
+func easingErr(value string, reason string, args ...interface{}) error {
+	return &EasingError{Value: value, Reason: fmt.Sprintf(reason, args...)}
+}
+
+//==============================================================================
+
+// parsedEasings caches the easings parsed by ParseEasing keyed by their
+// normalized value.
+var parsedEasings = struct {
+	rl sync.RWMutex
+	c  map[string]Easing
+}{c: make(map[string]Easing)}
+
+// ParseEasing returns the easing for the provided value, which is either the
+// name of a registered easing, matched regardless of its case and spacing, or
+// a css easing function: cubic-bezier(x1, y1, x2, y2), steps(count, position),
+// linear(points...), the step-start and step-end keywords or a spring(mass,
+// stiffness, damping, velocity). Parsed easings are cached, while invalid
+// values return a EasingError.
+func ParseEasing(value string) (Easing, error) {
+	key := normalizeEasing(value)
+
+	if es := easingProviders.Get(key); es != nil {
+		return es, nil
+	}
+
+	if es := easingProviders.Get(strings.TrimSpace(value)); es != nil {
+		return es, nil
+	}
+
+	parsedEasings.rl.RLock()
+	es, ok := parsedEasings.c[key]
+	parsedEasings.rl.RUnlock()
+
+	if ok {
+		return es, nil
+	}
+
+	es, err := parseEasing(value, key)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedEasings.rl.Lock()
+	parsedEasings.c[key] = es
+	parsedEasings.rl.Unlock()
+
+	return es, nil
+}
+
+// easingSpacing removes the spaces around the punctuation of easing functions.
+var easingSpacing = regexp.MustCompile(`\s*([(),])\s*`)
+
+// normalizeEasing returns the easing value lowercased with its spaces removed
+// except those separating the inputs of linear() points.
+func normalizeEasing(value string) string {
+	return easingSpacing.ReplaceAllString(strings.ToLower(strings.Join(strings.Fields(value), " ")), "$1")
+}
+
+// parseEasing parses the normalized easing value, where value is the value as
+// provided for errors.
+func parseEasing(value string, key string) (Easing, error) {
+	switch key {
+	case "":
+		return nil, easingErr(value, "no easing provided")
+	case "step-start":
+		return NewSteps(1, JumpStart), nil
+	case "step-end":
+		return NewSteps(1, JumpEnd), nil
+	}
+
+	start := strings.Index(key, "(")
+	if start < 0 || !strings.HasSuffix(key, ")") {
+		return nil, easingErr(value, "unknown easing name")
+	}
+
+	name := key[:start]
+	args := strings.Split(key[start+1:len(key)-1], ",")
+
+	switch name {
+	case "cubic-bezier":
+		return parseCubicBezier(value, args)
+	case "steps":
+		return parseSteps(value, args)
+	case "linear":
+		return parseLinear(value, args)
+	case "spring":
+		sp, err := ParseSpring(key)
+		if err != nil {
+			return nil, easingErr(value, "expected spring(mass, stiffness, damping, velocity) with a positive mass and stiffness")
+		}
+
+		return sp, nil
+	}
+
+	return nil, easingErr(value, "unknown easing function %q", name)
+}
+
+// parseCubicBezier parses the arguments of a cubic-bezier() easing.
+func parseCubicBezier(value string, args []string) (Easing, error) {
+	if len(args) != 4 {
+		return nil, easingErr(value, "cubic-bezier() expects 4 numbers but got %d", len(args))
+	}
+
+	var pts [4]float64
+
+	for ind, arg := range args {
+		num, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return nil, easingErr(value, "%q is not a number", arg)
+		}
+
+		pts[ind] = num
+	}
+
+	if pts[0] < 0 || pts[0] > 1 || pts[2] < 0 || pts[2] > 1 {
+		return nil, easingErr(value, "the x coordinates of cubic-bezier() must be between 0 and 1")
+	}
+
+	return NewSpline(pts[0], pts[1], pts[2], pts[3]), nil
+}
+
+// parseSteps parses the arguments of a steps() easing.
+func parseSteps(value string, args []string) (Easing, error) {
+	if len(args) != 1 && len(args) != 2 {
+		return nil, easingErr(value, "steps() expects a count and an optional position")
+	}
+
+	count, err := strconv.Atoi(args[0])
+	if err != nil {
+		return nil, easingErr(value, "%q is not a whole number of steps", args[0])
+	}
+
+	position := JumpEnd
+
+	if len(args) == 2 {
+		switch args[1] {
+		case "jump-start", "start":
+			position = JumpStart
+		case "jump-end", "end":
+			position = JumpEnd
+		case "jump-both":
+			position = JumpBoth
+		case "jump-none":
+			position = JumpNone
+		default:
+			return nil, easingErr(value, "unknown steps() position %q", args[1])
+		}
+	}
+
+	if count < 1 || (position == JumpNone && count < 2) {
+		return nil, easingErr(value, "steps() needs at least 1 step, or 2 for jump-none")
+	}
+
+	return NewSteps(count, position), nil
+}
+
+// parseLinear parses the arguments of a linear() easing, where each argument
+// is an output with up to two input percentages.
+func parseLinear(value string, args []string) (Easing, error) {
+	var points []LinearPoint
+
+	for _, arg := range args {
+		parts := strings.Fields(arg)
+		if len(parts) == 0 || len(parts) > 3 {
+			return nil, easingErr(value, "linear() expects an output with up to two percentages")
+		}
+
+		output, err := strconv.ParseFloat(parts[0], 64)
+		if err != nil {
+			return nil, easingErr(value, "%q is not a number", parts[0])
+		}
+
+		if len(parts) == 1 {
+			points = append(points, LinearPoint{Output: output})
+			continue
+		}
+
+		for _, part := range parts[1:] {
+			if !strings.HasSuffix(part, "%") {
+				return nil, easingErr(value, "%q is not a percentage", part)
+			}
+
+			input, err := strconv.ParseFloat(strings.TrimSuffix(part, "%"), 64)
+			if err != nil {
+				return nil, easingErr(value, "%q is not a percentage", part)
+			}
+
+			points = append(points, LinearPoint{Output: output, Input: input / 100, HasInput: true})
+		}
+	}
+
+	if len(points) < 2 {
+		return nil, easingErr(value, "linear() expects at least 2 points")
+	}
+
+	return NewLinear(points), nil
+}
+
+//==============================================================================
+
+// StepPosition defines where the jumps of a Steps easing occur.
+type StepPosition int
+
+// contains the different positions of the jumps of a Steps easing.
+const (
+	JumpEnd StepPosition = iota
+	JumpStart
+	JumpBoth
+	JumpNone
+)
+
+// Steps provides a easing which moves in equal jumps, as the css steps()
+// function.
+type Steps struct {
+	Count    int
+	Position StepPosition
+}
+
+// NewSteps returns a new Steps easing with the number of steps and the
+// position of its jumps.
+func NewSteps(count int, position StepPosition) *Steps {
+	st := Steps{Count: count, Position: position}
+	return &st
+}
+
+// Ease implements the Easing interface.
+func (s *Steps) Ease(progress float64) float64 {
+	step := int(progress * float64(s.Count))
+	if progress < 0 {
+		step--
+	}
+
+	jumps := s.Count
+
+	switch s.Position {
+	case JumpStart:
+		step++
+	case JumpBoth:
+		step++
+		jumps++
+	case JumpNone:
+		jumps--
+	}
+
+	if progress >= 0 && step < 0 {
+		step = 0
+	}
+
+	if progress <= 1 && step > jumps {
+		step = jumps
+	}
+
+	return float64(step) / float64(jumps)
+}
+
+//==============================================================================
+
+// LinearPoint defines a point of a Linear easing, where the input is only used
+// when HasInput is set, else it is spread evenly between its neighbours.
+type LinearPoint struct {
+	Input    float64
+	Output   float64
+	HasInput bool
+}
+
+// Linear provides a easing which interpolates linearly between its points, as
+// the css linear() function.
+type Linear struct {
+	Points []LinearPoint
+}
+
+// NewLinear returns a new Linear easing for the points, where missing inputs
+// are set as the css linear() function does: the first and last inputs
+// default to 0 and 1, inputs smaller than one before them take its value and
+// the rest are spread evenly between their neighbours.
+func NewLinear(points []LinearPoint) *Linear {
+	pts := append([]LinearPoint(nil), points...)
+
+	if !pts[0].HasInput {
+		pts[0].Input, pts[0].HasInput = 0, true
+	}
+
+	if last := len(pts) - 1; !pts[last].HasInput {
+		pts[last].Input, pts[last].HasInput = 1, true
+	}
+
+	max := pts[0].Input
+
+	for ind := range pts {
+		if !pts[ind].HasInput {
+			continue
+		}
+
+		if pts[ind].Input < max {
+			pts[ind].Input = max
+		}
+
+		max = pts[ind].Input
+	}
+
+	for ind := 1; ind < len(pts); ind++ {
+		if pts[ind].HasInput {
+			continue
+		}
+
+		end := ind
+		for !pts[end].HasInput {
+			end++
+		}
+
+		from, to := pts[ind-1].Input, pts[end].Input
+		spans := float64(end - ind + 1)
+
+		for run := ind; run < end; run++ {
+			pts[run].Input = from + (to-from)*float64(run-ind+1)/spans
+			pts[run].HasInput = true
+		}
+	}
+
+	ln := Linear{Points: pts}
+	return &ln
+}
+
+// Ease implements the Easing interface, extrapolating from the first or last
+// two points for progress outside of the points.
+func (l *Linear) Ease(progress float64) float64 {
+	pts := l.Points
+	last := len(pts) - 1
+
+	if progress <= pts[0].Input {
+		return extrapolate(pts[0], pts[1], progress)
+	}
+
+	if progress >= pts[last].Input {
+		return extrapolate(pts[last-1], pts[last], progress)
+	}
+
+	for ind := last; ind > 0; ind-- {
+		if progress >= pts[ind-1].Input {
+			return extrapolate(pts[ind-1], pts[ind], progress)
+		}
+	}
+
+	return pts[last].Output
+}
+
+// extrapolate returns the output on the line through the two points for the
+// input, where points sharing their input return the output of the latter.
+func extrapolate(from LinearPoint, to LinearPoint, input float64) float64 {
+	if to.Input == from.Input {
+		return to.Output
+	}
+
+	return Lerp(from.Output, to.Output, (input-from.Input)/(to.Input-from.Input))
+}
+
+//==============================================================================
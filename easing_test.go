@@ -0,0 +1,153 @@
+package govfx_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/influx6/govfx"
+)
+
+// TestParseEasing validates the parsing of css easing functions.
+func TestParseEasing(t *testing.T) {
+	expected := []struct {
+		easing  string
+		samples map[float64]float64
+	}{
+		{"cubic-bezier(0.25, 0.1, 0.25, 1)", map[float64]float64{0: 0, 0.5: 0.8024, 1: 1}},
+		{"cubic-bezier(0,0,1,1)", map[float64]float64{0.3: 0.3, 0.75: 0.75}},
+		{"steps(4)", map[float64]float64{0: 0, 0.3: 0.25, 0.99: 0.75, 1: 1}},
+		{"steps(4, jump-start)", map[float64]float64{0: 0.25, 0.3: 0.5, 1: 1}},
+		{"steps(3, jump-both)", map[float64]float64{0: 0.25, 0.5: 0.5, 1: 1}},
+		{"steps(3, jump-none)", map[float64]float64{0: 0, 0.5: 0.5, 1: 1}},
+		{"step-start", map[float64]float64{0: 1, 0.5: 1}},
+		{"step-end", map[float64]float64{0.5: 0, 1: 1}},
+		{"linear(0, 0.25 40%, 1)", map[float64]float64{0.2: 0.125, 0.7: 0.625, 1.2: 1.25}},
+		{"linear(0, 0.25, 1)", map[float64]float64{0.25: 0.125, 0.75: 0.625}},
+		{"linear(0, 0.5 25% 75%, 1)", map[float64]float64{0.5: 0.5, 0.125: 0.25}},
+		{"linear(0, 0.5 -10%, 1)", map[float64]float64{0.5: 0.75}},
+		{"linear(0.5 -50%, 1)", map[float64]float64{0: 2.0 / 3, -0.5: 0.5}},
+		{"snap", map[float64]float64{0: 0, 1: 1}},
+	}
+
+	for _, item := range expected {
+		es, err := govfx.ParseEasing(item.easing)
+		if err != nil {
+			t.Fatalf("Should have parsed %q: %s", item.easing, err)
+		}
+
+		for at, value := range item.samples {
+			if got := es.Ease(at); math.Abs(got-value) > 1e-4 {
+				t.Fatalf("Should have eased %q at %.2f to %.4f but got %.4f", item.easing, at, value, got)
+			}
+		}
+	}
+
+	first, _ := govfx.ParseEasing("steps(2, end)")
+	if second := govfx.GetEasing("Steps( 2 , END )"); second != first {
+		t.Fatal("Should have cached the parsed easing")
+	}
+
+	if govfx.GetEasing(" Ease-In ") != govfx.GetEasing("ease-in") {
+		t.Fatal("Should have matched the registered easing regardless of its case and spacing")
+	}
+
+	invalid := []string{
+		"cubic-bezier(1.5, 0, 0.5, 1)",
+		"cubic-bezier(0, 0, 1)",
+		"steps(0)",
+		"steps(1, jump-none)",
+		"steps(2, middle)",
+		"linear(1)",
+		"linear(0, 1 50)",
+		"wobble(1)",
+		"ease-sideways",
+	}
+
+	for _, value := range invalid {
+		_, err := govfx.ParseEasing(value)
+		if _, ok := err.(*govfx.EasingError); !ok {
+			t.Fatalf("Should have failed to parse %q", value)
+		}
+	}
+
+	if govfx.GetEasing("ease-sideways") != govfx.GetEasing(govfx.DefaultEasing) {
+		t.Fatal("Should have returned the default easing for a invalid easing")
+	}
+
+	if _, err := govfx.NewSequence("opacity", govfx.Value{"value": 1, "easing": "steps(0)"}); err == nil {
+		t.Fatal("Should have failed to create a sequence with a invalid easing")
+	}
+}
+
+// TestPennerEasings validates the penner easings and the easing combinators.
+func TestPennerEasings(t *testing.T) {
+	families := []string{"quad", "cubic", "quart", "quint", "sine", "expo", "circ", "back", "elastic", "bounce"}
+
+	for _, family := range families {
+		for _, form := range []string{"ease-in-", "ease-out-", "ease-in-out-", "ease-out-in-"} {
+			name := form + family
+
+			es := govfx.GetEasing(name)
+			if math.Abs(es.Ease(0)) > 1e-9 || math.Abs(es.Ease(1)-1) > 1e-9 {
+				t.Fatalf("Should have moved %q from 0 to 1", name)
+			}
+
+			if form == "ease-in-out-" || form == "ease-out-in-" {
+				if got := es.Ease(0.5); math.Abs(got-0.5) > 1e-9 {
+					t.Fatalf("Should have reached the middle of %q halfway but got %.4f", name, got)
+				}
+			}
+		}
+	}
+
+	expected := []struct {
+		easing string
+		at     float64
+		value  float64
+	}{
+		{"ease-in-quad", 0.5, 0.25},
+		{"ease-out-quad", 0.5, 0.75},
+		{"ease-in-out-cubic", 0.25, 0.0625},
+		{"ease-out-in-quad", 0.25, 0.375},
+		{"ease-in-sine", 0.5, 1 - math.Cos(math.Pi/4)},
+		{"ease-in-expo", 0.5, math.Pow(2, -5)},
+		{"ease-in-circ", 0.6, 0.2},
+		{"ease-in-back", 0.5, -0.0876975},
+		{"ease-in-elastic", 0.5, -0.015625},
+		{"ease-out-bounce", 0.5, 0.765625},
+		{"ease-in-bounce", 0.5, 0.234375},
+		{"ease-in", 0.5, 0.3153568},
+	}
+
+	for _, item := range expected {
+		if got := govfx.GetEasing(item.easing).Ease(item.at); math.Abs(got-item.value) > 1e-6 {
+			t.Fatalf("Should have eased %q at %.2f to %.6f but got %.6f", item.easing, item.at, item.value, got)
+		}
+	}
+
+	if got := (govfx.EaseIn{}).Ease(0.5); math.Abs(got-govfx.GetEasing("ease-in").Ease(0.5)) > 1e-9 {
+		t.Fatalf("Should have eased in as the css ease-in but got %.4f", got)
+	}
+
+	quad := govfx.EaseInQuad{}
+	linear := govfx.EasingFunc(func(t float64) float64 { return t })
+
+	combined := []struct {
+		name   string
+		easing govfx.Easing
+		at     float64
+		value  float64
+	}{
+		{"reverse", govfx.ReverseEasing(quad), 0.5, 0.75},
+		{"mirror", govfx.MirrorEasing(quad), 0.75, 0.875},
+		{"chain", govfx.ChainEasing(linear, quad, linear), 0.5, 0.4166667},
+		{"chain end", govfx.ChainEasing(linear, quad), 1, 1},
+		{"blend", govfx.BlendEasing(linear, quad, 0.5), 0.5, 0.375},
+	}
+
+	for _, item := range combined {
+		if got := item.easing.Ease(item.at); math.Abs(got-item.value) > 1e-6 {
+			t.Fatalf("Should have eased the %s easing at %.2f to %.6f but got %.6f", item.name, item.at, item.value, got)
+		}
+	}
+}
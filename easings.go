@@ -91,12 +91,17 @@ func GetEasingProvider() EasingProviders {
 }
 
 // GetEasing returns the easing function matching the specific easing function
-// name if it exists else it returns the default easing provider set by
-// DefaultEasing constant.
+// name or css easing function as parsed by ParseEasing, else it returns the
+// default easing provider set by DefaultEasing constant. Use ParseEasing to
+// get the error of a invalid easing.
 func GetEasing(easing string) Easing {
-	es := easingProviders.Get(easing)
-	if es == nil {
-		es = easingProviders.Get(DefaultEasing)
+	if easing == "" {
+		return easingProviders.Get(DefaultEasing)
+	}
+
+	es, err := ParseEasing(easing)
+	if err != nil {
+		return easingProviders.Get(DefaultEasing)
 	}
 
 	return es
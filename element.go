@@ -4,8 +4,7 @@ import (
 	"io"
 	"regexp"
 	"strings"
-
-	"honnef.co/go/js/dom"
+	"sync"
 )
 
 //==============================================================================
@@ -20,9 +19,9 @@ type CSSElem interface {
 
 // Elemental defines the interface for an elements decorator.
 type Elemental interface {
-	dom.Element
+	DOMElement
 
-	Init()
+	Init() error
 	Reset()
 	Clear()
 
@@ -33,35 +32,50 @@ type Elemental interface {
 	ReadFloat(string, string) (float64, bool, bool)
 
 	Update(delta float64, progress float64)
+	Seek(delta float64, progress float64)
 	Blend(blending float64)
 
 	CSS(io.Writer)
+	Declarations() Declarations
+
+	Styles() *StyleWriter
+	WriteStyle(Declarations) int
+	RestoreStyle()
+
+	Invalidate(...string)
+	Observe() bool
+	Unobserve()
 }
 
 // Elementals defines a lists of elementals,
 type Elementals []Elemental
 
-// Element defines a structure that holds ehances the dom.Element api.
+// Element defines a structure that holds ehances the DOMElement api.
 // Element provides a caching facility that helps to reduce layout checks
 // and improve animation by returning last used data. Also it provides
 // an appropriate method to update element properties apart from usings
 // inlined styles.
+// The computed styles of the element are fetched lazily, one property at a
+// time on its first read, and cached until invalidated.
 type Element struct {
-	dom.Element
-	props []Sequence
-	css   ComputedStyleMap // css holds the map of computed styles.
+	DOMElement
+	props     []Sequence
+	pseudo    string
+	cl        sync.Mutex
+	css       ComputedStyleMap // css caches the computed styles read so far.
+	styles    *StyleWriter
+	unobserve func()
+	transform *Decomposition // transform holds the transform before animating.
 }
 
-// NewElement returns an instancee of the Element struct.
-func NewElement(elem dom.Element, pseudo string) Elemental {
-	css, err := GetComputedStyleMap(elem, pseudo)
-	if err != nil {
-		panic(err)
-	}
-
+// NewElement returns an instancee of the Element struct, whose computed
+// styles are read through the current backend when first needed.
+func NewElement(elem DOMElement, pseudo string) Elemental {
 	em := Element{
-		css:     css,
-		Element: elem,
+		pseudo:     pseudo,
+		css:        make(ComputedStyleMap),
+		DOMElement: elem,
+		styles:     NewStyleWriter(elem),
 	}
 
 	return &em
@@ -72,11 +86,26 @@ func (e *Element) Add(css ...Sequence) {
 	e.props = append(e.props, css...)
 }
 
-// Init calls the Init() methods on all items in its property list.
-func (e *Element) Init() {
+// Init calls the Init() methods on all items in its property list, recording
+// the transform of the element the transform sequences are composed with. It
+// returns the error of the first item which failed to initialize.
+func (e *Element) Init() error {
+	e.transform = nil
+
+	for _, prop := range e.props {
+		if _, ok := asTransformer(prop); ok {
+			e.transform = baseTransform(e)
+			break
+		}
+	}
+
 	for _, prop := range e.props {
-		prop.Init(e)
+		if err := prop.Init(e); err != nil {
+			return err
+		}
 	}
+
+	return nil
 }
 
 // Reset resets the resetable sequences within the elements prop list.
@@ -104,17 +133,143 @@ func (e *Element) Update(d float64, timeline float64) {
 	}
 }
 
+// Seek sets the sequences of the element to their state at the progress, where
+// they keep no previous state to blend from.
+func (e *Element) Seek(d float64, timeline float64) {
+	for _, prop := range e.props {
+		seekSequence(prop, d, timeline)
+	}
+}
+
 // Clear empties the css sequence list for the element.
 func (e *Element) Clear() {
 	e.props = nil
 }
 
+// Declarations returns the combined declarations of all the sequences of the
+// element, where a property set by several sequences takes the value of the
+// last one. The transform functions of all Transformer sequences are composed
+// into a single transform declaration set last, on top of the transform of
+// the element recorded by Init.
+func (e *Element) Declarations() Declarations {
+	var decls Declarations
+	var tfs []Transformer
+
+	for _, seq := range e.props {
+		if tf, ok := asTransformer(seq); ok {
+			tfs = append(tfs, tf)
+			continue
+		}
+
+		for _, decl := range DeclarationsOf(seq) {
+			decls.Set(decl)
+		}
+	}
+
+	if len(tfs) != 0 {
+		decls.Set(transformDeclaration(e.transform, tfs))
+	}
+
+	return decls
+}
+
 // CSS collects all the internal css data to be writting and writes it out to the
-// passed writer.
+// passed writer as a single declaration block.
 func (e *Element) CSS(w io.Writer) {
-	for _, elem := range e.props {
-		elem.CSS(w)
+	e.Declarations().CSS(w)
+}
+
+// Styles returns the StyleWriter which writes the inline style of the element.
+func (e *Element) Styles() *StyleWriter {
+	return e.styles
+}
+
+// WriteStyle writes the declarations into the inline style of the element,
+// keeping its other inline declarations and skipping those unchanged since
+// their last write, returning the number of properties written. Properties
+// set inline as important keep their priority, as recorded by the StyleWriter.
+func (e *Element) WriteStyle(decls Declarations) int {
+	return e.styles.WriteDeclarations(decls)
+}
+
+// RestoreStyle restores the properties written into the inline style of the
+// element to their values before they were written.
+func (e *Element) RestoreStyle() {
+	e.styles.Restore()
+}
+
+// Invalidate drops the cached computed styles of the properties, else of all
+// properties if none is provided, hence their next read fetches them again.
+func (e *Element) Invalidate(props ...string) {
+	e.cl.Lock()
+	defer e.cl.Unlock()
+
+	if len(props) == 0 {
+		e.css = make(ComputedStyleMap)
+		return
+	}
+
+	for _, prop := range props {
+		delete(e.css, prop)
+	}
+}
+
+// Observe invalidates the cached computed styles of the element whenever its
+// class or style attribute changes, if the backend is a StyleObserver (eg a
+// MutationObserver within the browser). Returns false if the element can not
+// be observed. As the writes of its animations change the style attribute,
+// the values read by their sequences are then those of the last frame.
+func (e *Element) Observe() bool {
+	e.cl.Lock()
+	defer e.cl.Unlock()
+
+	if e.unobserve != nil {
+		return true
+	}
+
+	so, ok := GetBackend().(StyleObserver)
+	if !ok {
+		return false
 	}
+
+	e.unobserve = so.ObserveStyle(e.DOMElement, func() { e.Invalidate() })
+	return e.unobserve != nil
+}
+
+// Unobserve stops the invalidation started by Observe.
+func (e *Element) Unobserve() {
+	e.cl.Lock()
+	defer e.cl.Unlock()
+
+	if e.unobserve != nil {
+		e.unobserve()
+		e.unobserve = nil
+	}
+}
+
+// computed returns the computed style of the property, fetching it through
+// the backend if it is not cached. Properties the element does not have are
+// cached as missing.
+func (e *Element) computed(prop string) (*ComputedStyle, error) {
+	e.cl.Lock()
+	defer e.cl.Unlock()
+
+	if cs, ok := e.css[prop]; ok {
+		if cs == nil {
+			return nil, ErrNotFound
+		}
+
+		return cs, nil
+	}
+
+	cs, err := GetBackend().ComputedProperty(e.DOMElement, e.pseudo, prop)
+	if err != nil {
+		e.css[prop] = nil
+		return nil, err
+	}
+
+	e.css[prop] = cs
+	return cs, nil
 }
 
 var propName = regexp.MustCompile("([\\w\\-0-9]+)\\(?\\)?")
@@ -123,29 +278,34 @@ var propName = regexp.MustCompile("([\\w\\-0-9]+)\\(?\\)?")
 // values list and priority(whether it has !important attached).
 // If the property does not exists a false value is returned.
 func (e *Element) Read(prop string, selector string) (string, bool, bool) {
-	cs, err := e.css.Get(prop)
+	cs, err := e.computed(prop)
 	if err != nil {
 		return "", false, false
 	}
 
-	// If we the selector is not a empty string, then search deep within the
-	// property value list and return needed item else return false as last
-	// boolean return value to indicate a failure.
-	if strings.TrimSpace(selector) != "" {
-		for _, val := range cs.Values {
-			valName := propName.FindStringSubmatch(val)[1]
-			if valName != selector {
-				continue
-			}
+	val, ok := selectValue(cs, selector)
+	return val, cs.Priority, ok
+}
+
+// selectValue returns the value of the computed style, where a non empty
+// selector searches deep within the property value list for the item named by
+// the selector (eg "translateX" for "translateX(20px)"), else returns false as
+// last return value to indicate a failure.
+func selectValue(cs *ComputedStyle, selector string) (string, bool) {
+	if strings.TrimSpace(selector) == "" {
+		return cs.Value, true
+	}
 
-			return val, cs.Priority, true
+	for _, val := range cs.Values {
+		match := propName.FindStringSubmatch(val)
+		if match == nil || match[1] != selector {
+			continue
 		}
 
-		return cs.Value, cs.Priority, false
+		return val, true
 	}
 
-	// Read the value, return both value and true state.
-	return cs.Value, cs.Priority, true
+	return cs.Value, false
 }
 
 // ReadInt reads the given property and attempts to convert its value into a
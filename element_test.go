@@ -0,0 +1,142 @@
+package govfx_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influx6/govfx"
+)
+
+// countingBackend defines a headless backend which counts the properties
+// fetched from its computed styles.
+type countingBackend struct {
+	*govfx.Headless
+	fetches int
+}
+
+func (c *countingBackend) ComputedProperty(elem govfx.DOMElement, pseudo string, prop string) (*govfx.ComputedStyle, error) {
+	c.fetches++
+	return c.Headless.ComputedProperty(elem, pseudo, prop)
+}
+
+// TestElementStyleCache validates that the computed styles of elements are
+// fetched lazily, cached and invalidated.
+func TestElementStyleCache(t *testing.T) {
+	doc := newDocument(1)
+
+	backend := &countingBackend{Headless: doc}
+	govfx.UseBackend(backend)
+	defer govfx.UseBackend(doc)
+
+	item := govfx.QuerySelector(".item")
+	if backend.fetches != 0 {
+		t.Fatalf("Should have fetched no computed style on creation but got %d", backend.fetches)
+	}
+
+	item.ReadInt("width", "")
+	item.ReadInt("width", "")
+
+	if _, _, ok := item.Read("unknown-property", ""); ok {
+		t.Fatal("Should have not found the unknown property")
+	}
+
+	item.Read("unknown-property", "")
+
+	if backend.fetches != 2 {
+		t.Fatalf("Should have fetched each property once but got %d fetches", backend.fetches)
+	}
+
+	item.SetAttribute("style", "width: 40px; height: 20px")
+
+	if width, _, _ := item.ReadInt("width", ""); width != 100 {
+		t.Fatalf("Should have read the cached width of 100 but got %d", width)
+	}
+
+	item.Invalidate("width")
+
+	if width, _, _ := item.ReadInt("width", ""); width != 40 {
+		t.Fatalf("Should have read the invalidated width of 40 but got %d", width)
+	}
+
+	if height, _, _ := item.ReadInt("height", ""); height != 20 {
+		t.Fatalf("Should have read the height of 20 but got %d", height)
+	}
+
+	if !item.Observe() {
+		t.Fatal("Should have observed the element")
+	}
+
+	item.SetAttribute("class", "item wide")
+	doc.AddRule(".wide", "width: 300px")
+
+	item.SetAttribute("style", "height: 10px")
+
+	if width, _, _ := item.ReadInt("width", ""); width != 300 {
+		t.Fatalf("Should have invalidated the width on a style change but got %d", width)
+	}
+
+	item.Unobserve()
+	item.SetAttribute("style", "width: 10px !important")
+
+	if width, _, _ := item.ReadInt("width", ""); width != 300 {
+		t.Fatalf("Should have kept the cached width once unobserved but got %d", width)
+	}
+
+	item.Invalidate()
+
+	if width, priority, _ := item.ReadInt("width", ""); width != 10 || !priority {
+		t.Fatalf("Should have read the important width of 10 but got %d", width)
+	}
+}
+
+// TestElementStyleSeek validates that seeking evaluates the sequences from the
+// values recorded when they started, regardless of the computed styles read
+// from the animated elements.
+func TestElementStyleSeek(t *testing.T) {
+	newDocument(2)
+
+	items := govfx.QuerySelectorAll(".item")
+
+	timeline, err := govfx.Animate(govfx.Stat{
+		Duration: 1 * time.Second,
+	}, govfx.Values{
+		{"value": 500, "animate": "width", "easing": "linear"},
+	}, govfx.Elementals{items[0]})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timeline.SeekProgress(1)
+	items[0].Invalidate()
+
+	timeline.SeekProgress(0.5)
+	if width := widthOf(items[0]); width != 300 {
+		t.Fatalf("Should have seeked to the middle width of 300 but got %d", width)
+	}
+
+	timeline.SeekProgress(0)
+	if width := widthOf(items[0]); width != 100 {
+		t.Fatalf("Should have seeked to the start width of 100 but got %d", width)
+	}
+
+	if !items[1].Observe() {
+		t.Fatal("Should have observed the element")
+	}
+
+	relative, err := govfx.Animate(govfx.Stat{
+		Duration: 1 * time.Second,
+	}, govfx.Values{
+		{"value": "+=100", "animate": "width", "easing": "linear"},
+	}, govfx.Elementals{items[1]})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		relative.SeekProgress(1)
+
+		if width := widthOf(items[1]); width != 200 {
+			t.Fatalf("Should have seeked to the relative width of 200 but got %d", width)
+		}
+	}
+}
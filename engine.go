@@ -1,7 +1,9 @@
 package govfx
 
 import (
+	"math"
 	"strings"
+	"sync/atomic"
 
 	"github.com/fatih/camelcase"
 	"github.com/influx6/faux/loop"
@@ -13,14 +15,55 @@ import (
 // Animate provides the central engine for managing all animation calls.
 // Animate uses writer batching to reduce layout trashing. Hence  each frame
 // assigned for each animation call, will have all their writes batched
-// into one call.
-func Animate(stat Stat, b Values, elems Elementals) *Timeline {
-	frame := NewSeqBev(elems, stat, b)
-	return NewTimeline(ModeTimer{
+// into one call, made within the mutate phase of the Scheduler shared by all
+// timelines of the same loop engine, after the reads of every timeline. When
+// the Stat provides a Stagger, the timeline is extended to cover the duration
+// of the last element to start. When the Stat provides no duration, the
+// animation runs for as long as the springs used as its easings take to
+// settle. An error is returned if any of the values can not be animated on
+// the elements (eg a unknown animator or a invalid easing or target).
+func Animate(stat Stat, b Values, elems Elementals) (*Timeline, error) {
+	if stat.Duration <= 0 {
+		stat.Duration = SpringDuration(b)
+	}
+
+	frame, err := NewSeqBev(elems, stat, b)
+	if err != nil {
+		return nil, err
+	}
+
+	timeline := stat
+	timeline.Duration = frame.Span()
+
+	return NewTimeline(StatMode(stat), frame, timeline), nil
+}
+
+// StatMode returns the default timer configuration used for the provided
+// Stat.
+func StatMode(stat Stat) ModeTimer {
+	return ModeTimer{
 		Delay:             stat.Delay,
 		MaxMSPerUpdate:    0.01,
 		MaxDeltaPerUpdate: 2.5,
-	}, frame, stat)
+		Clock:             stat.Clock,
+	}
+}
+
+//==============================================================================
+
+// timeScale holds the bits of the global time scale applied to all timers.
+var timeScale = math.Float64bits(1)
+
+// SetTimeScale sets the global time scale applied to every running animation,
+// where 1 is normal speed and 0.1 slows all animations down to a tenth of
+// their speed, which is useful when debugging animations.
+func SetTimeScale(scale float64) {
+	atomic.StoreUint64(&timeScale, math.Float64bits(scale))
+}
+
+// TimeScale returns the global time scale applied to every animation.
+func TimeScale() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&timeScale))
 }
 
 //==============================================================================
@@ -44,6 +87,23 @@ func init() {
 		cased := strings.ToLower(strings.Join(camelcase.Split(name), "-"))
 		RegisterEasing(cased, NewSpline(vals[0], vals[1], vals[2], vals[3]))
 	}
+
+	// Replace the bezier approximations of the penner easings with their
+	// exact forms.
+	registerPennerEasings()
+
+	RegisterEasing("spring", NewSpring(1, 100, 10, 0))
+
+	// Register the css3 easings not provided by the precalculated values.
+	for name, value := range CSS3Easings {
+		if easingProviders.Get(name) != nil {
+			continue
+		}
+
+		if es, err := ParseEasing(value); err == nil {
+			RegisterEasing(name, es)
+		}
+	}
 }
 
 //==============================================================================
@@ -23,7 +23,7 @@ func main() {
 	})
 
 	elems := govfx.QuerySelectorAll(".zapps")
-	width := govfx.Animate(govfx.Stat{
+	width, err := govfx.Animate(govfx.Stat{
 		Duration: 1 * time.Second,
 		Loop:     2,
 		Reverse:  true,
@@ -33,6 +33,9 @@ func main() {
 	}, govfx.Values{
 		{"value": 500, "animate": "width", "easing": "ease-in"},
 	}, elems)
+	if err != nil {
+		panic(err)
+	}
 
 	<-width.Simulate()
 
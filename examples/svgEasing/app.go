@@ -22,15 +22,19 @@ func main() {
 		fmt.Printf("Animation Is	 Progressing at %.4f .\n", dl)
 	})
 
-	easer := govfx.NewSVGPathEaser(govfx.SVGConfig{
+	easer, err := govfx.NewSVGPathEaser(govfx.SVGConfig{
 		SVGPath:      "M0,100 C40,100 50,90 50,50 C50,10 60,3.46944696e-18 100,0",
 		Width:        100,
 		Height:       100,
 		SamplingSize: 300,
 	})
 
+	if err != nil {
+		panic(err)
+	}
+
 	elems := govfx.QuerySelectorAll(".zapps")
-	width := govfx.Animate(govfx.Stat{
+	width, err := govfx.Animate(govfx.Stat{
 		Duration: 1 * time.Second,
 		Loop:     2,
 		Reverse:  true,
@@ -40,6 +44,9 @@ func main() {
 	}, govfx.Values{
 		{"value": 700, "animate": "width", "easer": easer},
 	}, elems)
+	if err != nil {
+		panic(err)
+	}
 
 	<-width.Simulate()
 
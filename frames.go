@@ -1,11 +1,11 @@
 package govfx
 
 import (
-	"bytes"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/influx6/faux/loop"
 	"honnef.co/go/js/dom"
 )
 
@@ -20,25 +20,43 @@ var AnimationStepsPerSec int64 = 60
 // Block represents a single state instance for rendering at a specific moment
 // in time.
 type Block struct {
-	Elem Elemental
-	Buf  *bytes.Buffer
+	Elem  Elemental
+	Decls Declarations
 }
 
-// Do writes the giving buffer into the style attribute of the element.
-func (b *Block) Do() {
-	b.Elem.SetAttribute("style", b.Buf.String())
+// Do writes the declarations of the block into the inline style of the
+// element, keeping its other inline declarations and skipping those which did
+// not change since they were last written. Returns the number of properties
+// written.
+func (b *Block) Do() int {
+	return b.Elem.WriteStyle(b.Decls)
 }
 
 // BlockMoment represents a full moment or rendering of the state of a element
 // in time.
 type BlockMoment []Block
 
-// Run calls all the Do() methods of its internal blocks.
-func (b BlockMoment) Run() {
+// Run calls all the Do() methods of its internal blocks, returning the number
+// of writes made.
+func (b BlockMoment) Run() WriteCount {
+	var count WriteCount
+
 	for _, block := range b {
 		// TODO: should we Go-routine this, to ensure elements update asynchronousely?
-		block.Do()
+		count = count.Add(block.count())
+	}
+
+	return count
+}
+
+// count writes the block, returning the number of writes made.
+func (b *Block) count() WriteCount {
+	written := b.Do()
+	if written == 0 {
+		return WriteCount{}
 	}
+
+	return WriteCount{Elements: 1, Properties: int64(written)}
 }
 
 //==============================================================================
@@ -52,61 +70,76 @@ type Stat struct {
 	Begin    Listener
 	End      Listener
 	Progress Listener
+	Clock    Clock
+	Stagger  Stagger
 }
 
 // SeqBev defines a sequence producer interface.
 type SeqBev struct {
 	Stat
 
-	blocks []BlockMoment
-
 	reversing bool
 	reversed  bool
 
-	elems Elementals
-	ideas Values
+	elems   Elementals
+	ideas   Values
+	offsets []float64
+
+	flymode int64
+	simMode int64
 
-	flymode  int64
-	flyIndex int64
-	simMode  int64
+	writeElements   int64
+	writeProperties int64
 }
 
 // QuerySequence uses a selector to retrieve the desired elements needed
 // to be animated, returning the frame for the animation sequence.
-func QuerySequence(selector string, stat Stat, vs Values) *SeqBev {
+func QuerySequence(selector string, stat Stat, vs Values) (*SeqBev, error) {
 	return ElementalSequence(TransformElements(QuerySelectorAll(selector)), stat, vs)
 }
 
 // DOMSequence returns a new SeqBev transforming the lists of
 // accordingly dom.Elements into its desired elementals for the animation
 // sequence.
-func DOMSequence(elems []dom.Element, stat Stat, vs Values) *SeqBev {
+func DOMSequence(elems []dom.Element, stat Stat, vs Values) (*SeqBev, error) {
 	return ElementalSequence(TransformElements(elems), stat, vs)
 }
 
 // ElementalSequence returns a new frame using the selected Elementals for
 // the animation sequence.
-func ElementalSequence(elems Elementals, stat Stat, id Values) *SeqBev {
-	ani := NewSeqBev(elems, stat, id)
-	return ani
+func ElementalSequence(elems Elementals, stat Stat, id Values) (*SeqBev, error) {
+	return NewSeqBev(elems, stat, id)
 }
 
-// NewSeqBev returns a new instance of a SeqBev.
-func NewSeqBev(elems Elementals, stat Stat, ideas Values) *SeqBev {
+// NewSeqBev returns a new instance of a SeqBev, else returns the error of the
+// first sequence which could not be created or initialized for its element.
+func NewSeqBev(elems Elementals, stat Stat, ideas Values) (*SeqBev, error) {
 	f := SeqBev{
 		Stat:  stat,
 		elems: elems,
 	}
 
-	for _, elem := range elems {
-		// Add the sequence into the element tree.
-		elem.Add(GenerateSequence(ideas)...)
+	for _, offset := range stat.Stagger.Offsets(elems) {
+		f.offsets = append(f.offsets, offset.Seconds())
+	}
+
+	for index, elem := range elems {
+		// Add the sequence into the element tree, with the function-valued
+		// properties resolved for the element.
+		seqs, err := GenerateSequence(ideas.Resolve(index, elem))
+		if err != nil {
+			return nil, err
+		}
+
+		elem.Add(seqs...)
 
 		// Init the properties with the element.
-		elem.Init()
+		if err := elem.Init(); err != nil {
+			return nil, err
+		}
 	}
 
-	return &f
+	return &f, nil
 }
 
 // SimulationOFF puts off the sequence frame simulation mode returning things
@@ -129,13 +162,7 @@ func (f *SeqBev) Completed(cycle int) {
 // Done returns true/false if the sequence has completed a full run.
 // Where a full run is a completed cycle + reveres run.
 func (f *SeqBev) Done() bool {
-	flymod := int(atomic.LoadInt64(&f.flymode))
-
-	if atomic.LoadInt64(&f.flyIndex) <= 0 {
-		f.reversed = true
-	}
-
-	if flymod < 1 {
+	if atomic.LoadInt64(&f.flymode) < 1 {
 		return false
 	}
 
@@ -150,72 +177,24 @@ func (f *SeqBev) Done() bool {
 func (f *SeqBev) Reset() {
 	f.reversed = false
 	f.reversing = false
-	atomic.StoreInt64(&f.flyIndex, 0)
+	atomic.StoreInt64(&f.flymode, 0)
 }
 
-// RenderReverse reverses the rendering of the sequence by calling the
-// index in reverese.
+// RenderReverse renders the current state of the elements computed by the
+// reversed updates.
 func (f *SeqBev) RenderReverse(delta float64) {
-	ind := int(atomic.LoadInt64(&f.flyIndex))
-	total := len(f.blocks)
-
-	if ind >= total {
-		ind = total - 1
-	}
-
-	if ind < 0 {
-		ind = 0
-	}
-
-	blocks := f.blocks[ind]
-
-	if atomic.LoadInt64(&f.simMode) < 1 {
-		blocks.Run()
-	}
-
-	atomic.AddInt64(&f.flyIndex, -1)
+	f.write()
 }
 
 // Render renders the current frame feeding the delta value if needed to its
-// internals.
+// internals, where every frame is computed from the current state of the
+// sequences.
 func (f *SeqBev) Render(delta float64) {
-	flymod := int(atomic.LoadInt64(&f.flymode))
-
-	ind := atomic.LoadInt64(&f.flyIndex)
-
-	if int(ind) >= len(f.blocks) {
-		f.blocks = append(f.blocks, []Block{})
-	}
-
-	blocks := f.blocks[ind]
-
-	if flymod > 0 {
-		blocks.Run()
-		atomic.AddInt64(&f.flyIndex, 1)
-		return
-	}
-
-	// Build the blocks list for this current index.
 	for _, elem := range f.elems {
 		elem.Blend(delta)
-
-		var buf bytes.Buffer
-		elem.CSS(&buf)
-
-		block := Block{
-			Elem: elem,
-			Buf:  &buf,
-		}
-
-		blocks = append(blocks, block)
-
-		if int(atomic.LoadInt64(&f.simMode)) < 1 {
-			block.Do()
-		}
 	}
 
-	f.blocks[ind] = blocks
-	atomic.AddInt64(&f.flyIndex, 1)
+	f.write()
 }
 
 //==============================================================================
@@ -250,13 +229,143 @@ func (f *SeqBev) Update(delta, total float64, timeline float64) {
 		return
 	}
 
+	for ind, elem := range f.elems {
+		elem.Update(delta, f.local(ind, total))
+	}
+}
+
+// Span returns the total duration of the sequence, which is the duration of
+// each element with the largest stagger delay.
+func (f *SeqBev) Span() time.Duration {
+	var max float64
+
+	for _, offset := range f.offsets {
+		if offset > max {
+			max = offset
+		}
+	}
+
+	return f.Stat.Duration + time.Duration(max*float64(time.Second))
+}
+
+// local returns the progress between 0 and 1 of the element at the giving
+// index for the elapsed seconds of the sequence, accounting for its stagger
+// delay.
+func (f *SeqBev) local(index int, elapsed float64) float64 {
+	duration := f.Stat.Duration.Seconds()
+	if duration <= 0 {
+		return 1
+	}
+
+	progress := (elapsed - f.offsets[index]) / duration
+
+	if progress < 0 {
+		return 0
+	}
+
+	if progress > 1 {
+		return 1
+	}
+
+	return progress
+}
+
+// Seek sets all elements to their state at the provided elapsed time and
+// renders them.
+func (f *SeqBev) Seek(step float64, elapsed float64, total float64) {
+	f.reversed = false
+	f.reversing = false
+	atomic.StoreInt64(&f.flymode, 0)
+
+	f.evaluate(step, elapsed)
+	f.write()
+}
+
+// Settle sets all elements to their exact state at the end of the sequence and
+// renders them, as a completed sequence ignores further updates.
+func (f *SeqBev) Settle(step float64, total float64) {
+	f.evaluate(step, total)
+	f.write()
+}
+
+// Cancel restores the inline styles written by the sequence to their values
+// before the animation.
+func (f *SeqBev) Cancel() {
+	for _, elem := range f.elems {
+		elem := elem
+
+		mutate(f.engine(), func() {
+			before := elem.Styles().Writes()
+			elem.RestoreStyle()
+
+			after := elem.Styles().Writes()
+			f.counted(WriteCount{
+				Elements:   after.Elements - before.Elements,
+				Properties: after.Properties - before.Properties,
+			})
+		})
+	}
+}
+
+// Writes returns the number of writes made to the DOM by the sequence.
+func (f *SeqBev) Writes() WriteCount {
+	return WriteCount{
+		Elements:   atomic.LoadInt64(&f.writeElements),
+		Properties: atomic.LoadInt64(&f.writeProperties),
+	}
+}
+
+// counted adds the writes to the writes made by the sequence.
+func (f *SeqBev) counted(count WriteCount) {
+	atomic.AddInt64(&f.writeElements, count.Elements)
+	atomic.AddInt64(&f.writeProperties, count.Properties)
+}
+
+// engine returns the loop engine the timeline of the sequence runs on, whose
+// Scheduler batches the writes of the sequence.
+func (f *SeqBev) engine() loop.GameEngine {
+	return StatMode(f.Stat).engine()
+}
+
+// write renders the current state of all elements unless simulating.
+func (f *SeqBev) write() {
+	if atomic.LoadInt64(&f.simMode) > 0 {
+		return
+	}
+
 	for _, elem := range f.elems {
-		elem.Update(delta, timeline)
+		block := Block{Elem: elem, Decls: elem.Declarations()}
+
+		mutate(f.engine(), func() {
+			f.counted(block.count())
+		})
+	}
+}
+
+// UpdateReverse updates the elements to their state for the reversed run,
+// by evaluating their sequences at the mirrored progress (1 - timeline) of
+// the forward run.
+func (f *SeqBev) UpdateReverse(delta, progress float64, timeline float64) {
+	if timeline >= 1 {
+		timeline = 1
+		f.reversed = true
 	}
+
+	f.reversing = true
+
+	span := f.Span().Seconds()
+	f.evaluate(delta, (1-timeline)*span)
 }
 
-// UpdateReverse calls a reverse procedure on the sequence being runned.
-func (f *SeqBev) UpdateReverse(delta float64) {
+// evaluate sets all elements to their state at the provided elapsed time by
+// seeking their sequences to the progress of that moment, as sequences are
+// evaluated purely from their progress. The start and target values of the
+// sequences are those recorded once when they were initialized, hence neither
+// the animated state nor the computed styles of the elements are read again.
+func (f *SeqBev) evaluate(step float64, elapsed float64) {
+	for ind, elem := range f.elems {
+		elem.Seek(step, f.local(ind, elapsed))
+	}
 }
 
 //==============================================================================
@@ -0,0 +1,413 @@
+package govfx
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+//==============================================================================
+
+// Group defines a composition of timelines which are placed at different
+// moments of a single timeline. A Group is driven by its own Timeline, hence
+// it supports starting, pausing, seeking, looping and reversing all its
+// timelines as one, while emitting its begin, progress and end events through
+// the listeners of its Stat. Timelines added to a Group must not be started on
+// their own.
+// When the group reverses, its timelines play backward in the opposite order,
+// hence each timeline emits its end event once the forward run passes it,
+// then its begin event when the reversed run reaches its end and its end
+// event again when the reversed run reaches its start.
+type Group struct {
+	stat     Stat
+	items    []*groupItem
+	timeline *Timeline
+	at       int64
+}
+
+// groupItem defines a timeline placed within a group, along with the events
+// emitted by it within the forward and the reversed runs of the group.
+type groupItem struct {
+	timeline *Timeline
+	start    time.Duration
+	begun    bool
+	ended    bool
+
+	reverseBegun bool
+	reverseEnded bool
+}
+
+// NewGroup returns a new empty Group, where the Duration of the Stat is
+// ignored as it is computed from the timelines of the group.
+func NewGroup(stat Stat) *Group {
+	g := Group{stat: stat, at: -1}
+	return &g
+}
+
+// NewSequentialGroup returns a new Group which runs the timelines one after
+// the other.
+func NewSequentialGroup(stat Stat, timelines ...*Timeline) *Group {
+	g := NewGroup(stat)
+
+	for _, tl := range timelines {
+		g.Then(tl, 0)
+	}
+
+	return g
+}
+
+// NewParallelGroup returns a new Group which runs all the timelines at the
+// same time.
+func NewParallelGroup(stat Stat, timelines ...*Timeline) *Group {
+	g := NewGroup(stat)
+
+	for _, tl := range timelines {
+		g.Add(tl, 0)
+	}
+
+	return g
+}
+
+// NewStaggeredGroup returns a new Group which starts each timeline after the
+// start of the timeline before it by the provided offset.
+func NewStaggeredGroup(stat Stat, offset time.Duration, timelines ...*Timeline) *Group {
+	g := NewGroup(stat)
+
+	for ind, tl := range timelines {
+		if ind == 0 {
+			g.Add(tl, 0)
+			continue
+		}
+
+		g.With(tl, offset)
+	}
+
+	return g
+}
+
+// Add places the timeline at the provided moment from the start of the group.
+func (g *Group) Add(tl *Timeline, at time.Duration) *Group {
+	if at < 0 {
+		at = 0
+	}
+
+	g.items = append(g.items, &groupItem{timeline: tl, start: at})
+	return g
+}
+
+// Then places the timeline after the end of the last added timeline, shifted
+// by the provided offset, where a negative offset starts the timeline before
+// the last one ends (eg -200ms starts it 200ms before the last one ends).
+func (g *Group) Then(tl *Timeline, offset time.Duration) *Group {
+	if len(g.items) == 0 {
+		return g.Add(tl, offset)
+	}
+
+	last := g.items[len(g.items)-1]
+	return g.Add(tl, last.start+last.timeline.Length()+offset)
+}
+
+// With places the timeline from the start of the last added timeline, shifted
+// by the provided offset.
+func (g *Group) With(tl *Timeline, offset time.Duration) *Group {
+	if len(g.items) == 0 {
+		return g.Add(tl, offset)
+	}
+
+	last := g.items[len(g.items)-1]
+	return g.Add(tl, last.start+offset)
+}
+
+// Duration returns the total duration of the group, which is the moment the
+// last of its timelines ends.
+func (g *Group) Duration() time.Duration {
+	var duration time.Duration
+
+	for _, item := range g.items {
+		if end := item.start + item.timeline.Length(); end > duration {
+			duration = end
+		}
+	}
+
+	return duration
+}
+
+// Timeline returns the Timeline driving the group, creating it if it does not
+// exists. Timelines added after this call are not accounted for in the
+// duration of the group.
+func (g *Group) Timeline() *Timeline {
+	if g.timeline == nil {
+		stat := g.stat
+		stat.Duration = g.Duration()
+		g.timeline = NewTimeline(StatMode(stat), groupBehaviour{g}, stat)
+	}
+
+	return g.timeline
+}
+
+// Start starts running the group.
+func (g *Group) Start() {
+	g.Timeline().Start()
+}
+
+// Pause pauses the group if its started.
+func (g *Group) Pause() {
+	g.Timeline().Pause()
+}
+
+// Resume resumes the group if its paused.
+func (g *Group) Resume() {
+	g.Timeline().Resume()
+}
+
+// Cancel stops the group, restoring the inline styles changed by all its
+// timelines.
+func (g *Group) Cancel() {
+	g.Timeline().Cancel()
+}
+
+// Writes returns the number of writes made to the DOM by all the timelines.
+func (g *Group) Writes() WriteCount {
+	return g.Timeline().Writes()
+}
+
+// Seek moves the group to the provided moment from its start.
+func (g *Group) Seek(d time.Duration) {
+	g.Timeline().Seek(d)
+}
+
+// SeekProgress moves the group to the provided progress between 0 and 1.
+func (g *Group) SeekProgress(progress float64) {
+	g.Timeline().SeekProgress(progress)
+}
+
+// SetRate sets the playback rate of the group.
+func (g *Group) SetRate(rate float64) {
+	g.Timeline().SetRate(rate)
+}
+
+//==============================================================================
+
+// drive records the moment of the group the timelines must be set to on the
+// next render, emitting the begin and end events of the timelines when
+// emit is true.
+func (g *Group) drive(at float64, emit bool) {
+	atomic.StoreInt64(&g.at, int64(at*float64(time.Second)))
+
+	if !emit {
+		return
+	}
+
+	moment := time.Duration(at * float64(time.Second))
+
+	for _, item := range g.items {
+		fb, ok := item.timeline.tb.(TimelineEmitable)
+		if !ok {
+			continue
+		}
+
+		local := moment - item.start
+		if local < 0 {
+			continue
+		}
+
+		if !item.begun {
+			item.begun = true
+			fb.EmitBegin(local.Seconds())
+		}
+
+		if local >= item.timeline.Length() {
+			if !item.ended {
+				item.ended = true
+				fb.EmitEnd(local.Seconds())
+			}
+
+			continue
+		}
+
+		fb.EmitProgress(local.Seconds())
+	}
+}
+
+// driveReverse records the moment of the reversed run of the group the
+// timelines must be set to on the next render, emitting the events of the
+// timelines in the order they play backward: the end of the forward run of
+// every timeline not yet ended, then the begin event of a timeline once the
+// moment is within it and its end event once the moment reaches its start.
+func (g *Group) driveReverse(at float64) {
+	atomic.StoreInt64(&g.at, int64(at*float64(time.Second)))
+
+	moment := time.Duration(at * float64(time.Second))
+
+	for ind := len(g.items) - 1; ind >= 0; ind-- {
+		item := g.items[ind]
+
+		fb, ok := item.timeline.tb.(TimelineEmitable)
+		if !ok {
+			continue
+		}
+
+		length := item.timeline.Length()
+
+		if item.begun && !item.ended {
+			item.ended = true
+			fb.EmitEnd(length.Seconds())
+		}
+
+		local := moment - item.start
+		if local >= length || item.reverseEnded {
+			continue
+		}
+
+		if local < 0 {
+			local = 0
+		}
+
+		if !item.reverseBegun {
+			item.reverseBegun = true
+			fb.EmitBegin(local.Seconds())
+		}
+
+		if local <= 0 {
+			item.reverseEnded = true
+			fb.EmitEnd(0)
+			continue
+		}
+
+		fb.EmitProgress(local.Seconds())
+	}
+}
+
+// apply sets every timeline to its state at the recorded moment of the group.
+func (g *Group) apply() {
+	at := atomic.SwapInt64(&g.at, -1)
+	if at < 0 {
+		return
+	}
+
+	moment := time.Duration(at)
+
+	for _, item := range g.items {
+		tl := item.timeline
+		local := moment - item.start
+
+		if local < 0 {
+			local = 0
+		}
+
+		// Find the moment within the current loop of the timeline.
+		run := tl.stat.Delay + tl.span()
+		if length := tl.Length(); local >= length {
+			local = run
+		} else if run > 0 {
+			local = local % run
+		}
+
+		tl.Seek(local)
+	}
+}
+
+//==============================================================================
+
+// groupBehaviour implements the TimelineBehaviour interface for the Timeline
+// driving a Group.
+type groupBehaviour struct {
+	*Group
+}
+
+// Update implements the TimelineBehaviour interface and drives the timelines
+// to their state at the provided progress.
+func (b groupBehaviour) Update(delta, progress float64, timeline float64) {
+	b.drive(progress, true)
+}
+
+// UpdateReverse implements the TimelineBehaviour interface and drives the
+// timelines to their state at the mirrored progress of the group.
+func (b groupBehaviour) UpdateReverse(delta, progress float64, timeline float64) {
+	if timeline > 1 {
+		timeline = 1
+	}
+
+	b.driveReverse((1 - timeline) * b.Duration().Seconds())
+}
+
+// Render implements the TimelineBehaviour interface and sets the timelines
+// to their state.
+func (b groupBehaviour) Render(interpolate float64) {
+	b.apply()
+}
+
+// RenderReverse implements the TimelineBehaviour interface and sets the
+// timelines to their state.
+func (b groupBehaviour) RenderReverse(interpolate float64) {
+	b.apply()
+}
+
+// Seek implements the TimelineBehaviourSeeker interface and sets the
+// timelines to their state at the provided elapsed time.
+func (b groupBehaviour) Seek(step float64, elapsed float64, total float64) {
+	b.drive(elapsed, false)
+	b.apply()
+}
+
+// Cancel implements the TimelineBehaviourCanceler interface and cancels the
+// timelines, in reverse so the earliest restores the original values last.
+func (b groupBehaviour) Cancel() {
+	for ind := len(b.items) - 1; ind >= 0; ind-- {
+		b.items[ind].timeline.Cancel()
+	}
+}
+
+// Writes implements the TimelineBehaviourWriteCounter interface and returns
+// the writes made by all the timelines.
+func (b groupBehaviour) Writes() WriteCount {
+	var count WriteCount
+
+	for _, item := range b.items {
+		count = count.Add(item.timeline.Writes())
+	}
+
+	return count
+}
+
+// Completed implements the TimelineBehaviour interface.
+func (b groupBehaviour) Completed(cycle int) {}
+
+// Done implements the TimelineBehaviour interface.
+func (b groupBehaviour) Done() bool {
+	return true
+}
+
+// Reset resets the event states of the timelines for a new loop.
+func (b groupBehaviour) Reset() {
+	for _, item := range b.items {
+		item.begun = false
+		item.ended = false
+		item.reverseBegun = false
+		item.reverseEnded = false
+	}
+}
+
+//==============================================================================
+
+// EmitBegin emits the begin signal to the listener supplied in the stat.
+func (b groupBehaviour) EmitBegin(delta float64) {
+	if b.stat.Begin != nil {
+		b.stat.Begin.Emit(delta)
+	}
+}
+
+// EmitProgress emits the progress signal to the listener supplied in the stat.
+func (b groupBehaviour) EmitProgress(delta float64) {
+	if b.stat.Progress != nil {
+		b.stat.Progress.Emit(delta)
+	}
+}
+
+// EmitEnd emits the ending signal to the listener supplied in the stat.
+func (b groupBehaviour) EmitEnd(delta float64) {
+	if b.stat.End != nil {
+		b.stat.End.Emit(delta)
+	}
+}
+
+//==============================================================================
@@ -0,0 +1,186 @@
+package govfx_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influx6/govfx"
+)
+
+// newTestGroup returns a group running two timelines of the "test-offset"
+// sequence one after the other, overlapping by 200ms, with the events of the
+// group and its timelines recorded into the returned list.
+func newTestGroup(t *testing.T, stat govfx.Stat) (*govfx.Group, *[]string) {
+	doc := newDocument(0)
+
+	var events []string
+
+	record := func(name string) govfx.Listener {
+		return govfx.NewListener(func(float64) { events = append(events, name) })
+	}
+
+	var timelines []*govfx.Timeline
+
+	for _, name := range []string{"first", "second"} {
+		item := doc.CreateElement("div")
+		item.SetAttribute("id", name)
+		item.SetAttribute("style", "left: 0px")
+		doc.Body().AppendChild(item)
+
+		timeline, err := govfx.Animate(govfx.Stat{
+			Duration: 1 * time.Second,
+			Begin:    record(name + "-begin"),
+			End:      record(name + "-end"),
+		}, govfx.Values{
+			{"value": 100.0, "animate": "test-offset", "easing": "linear"},
+		}, govfx.QuerySelectorAll("#"+name))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		timelines = append(timelines, timeline)
+	}
+
+	stat.Begin = record("group-begin")
+	stat.End = record("group-end")
+
+	group := govfx.NewGroup(stat).Then(timelines[0], 0).Then(timelines[1], -200*time.Millisecond)
+	return group, &events
+}
+
+// expectEvents fails the test if the events do not match the expected events.
+func expectEvents(t *testing.T, events []string, expected ...string) {
+	if len(events) != len(expected) {
+		t.Fatalf("Should have emitted %v but got %v", expected, events)
+	}
+
+	for ind, name := range expected {
+		if events[ind] != name {
+			t.Fatalf("Should have emitted %v but got %v", expected, events)
+		}
+	}
+}
+
+// leftOf returns the left offset written into the style of the element.
+func leftOf(selector string) int {
+	return govfx.ParseInt(govfx.QuerySelector(selector).GetAttribute("style"))
+}
+
+// TestGroup validates the composition of timelines within a group.
+func TestGroup(t *testing.T) {
+	clock := govfx.NewManualClock(time.Unix(0, 0))
+	group, events := newTestGroup(t, govfx.Stat{Clock: clock})
+
+	if duration := group.Duration(); duration != 1800*time.Millisecond {
+		t.Fatalf("Should have a duration of 1.8s: %s", duration)
+	}
+
+	first := govfx.QuerySelector("#first")
+	second := govfx.QuerySelector("#second")
+
+	group.Seek(500 * time.Millisecond)
+	if left := govfx.ParseInt(first.GetAttribute("style")); left != 50 {
+		t.Fatalf("Should have the first timeline halfway: %d", left)
+	}
+
+	if left := govfx.ParseInt(second.GetAttribute("style")); left != 0 {
+		t.Fatalf("Should have the second timeline at its start: %d", left)
+	}
+
+	group.Seek(1300 * time.Millisecond)
+	if left := govfx.ParseInt(second.GetAttribute("style")); left != 50 {
+		t.Fatalf("Should have the second timeline halfway: %d", left)
+	}
+
+	group.Seek(0)
+	group.Start()
+	clock.Frames(150, time.Second/60)
+
+	expectEvents(t, *events, "group-begin", "first-begin", "second-begin", "first-end", "second-end", "group-end")
+
+	if left := govfx.ParseInt(second.GetAttribute("style")); left != 100 {
+		t.Fatalf("Should have the second timeline at its end: %d", left)
+	}
+}
+
+// TestGroupPause validates that pausing a group freezes all its timelines
+// until it is resumed.
+func TestGroupPause(t *testing.T) {
+	clock := govfx.NewManualClock(time.Unix(0, 0))
+	group, events := newTestGroup(t, govfx.Stat{Clock: clock})
+
+	group.Start()
+	clock.Frames(30, time.Second/60)
+	group.Pause()
+
+	left := leftOf("#first")
+	if left == 0 || left == 100 {
+		t.Fatalf("Should have paused the first timeline midway: %d", left)
+	}
+
+	clock.Frames(120, time.Second/60)
+
+	if current := leftOf("#first"); current != left {
+		t.Fatalf("Should have kept the first timeline while paused: %d and %d", current, left)
+	}
+
+	expectEvents(t, *events, "group-begin", "first-begin")
+
+	group.Resume()
+	clock.Frames(120, time.Second/60)
+
+	expectEvents(t, *events, "group-begin", "first-begin", "second-begin", "first-end", "second-end", "group-end")
+
+	if left := leftOf("#second"); left != 100 {
+		t.Fatalf("Should have the second timeline at its end: %d", left)
+	}
+}
+
+// TestGroupLoop validates that a looping group replays its timelines, along
+// with their events, for every loop.
+func TestGroupLoop(t *testing.T) {
+	clock := govfx.NewManualClock(time.Unix(0, 0))
+	group, events := newTestGroup(t, govfx.Stat{Clock: clock, Loop: 2})
+
+	group.Start()
+
+	for i := 0; i < 400 && clock.Loops() > 0; i++ {
+		clock.Step(time.Second / 60)
+	}
+
+	expectEvents(t, *events,
+		"group-begin", "first-begin", "second-begin", "first-end", "second-end",
+		"first-begin", "second-begin", "first-end", "second-end", "group-end",
+	)
+
+	if left := leftOf("#second"); left != 100 {
+		t.Fatalf("Should have the second timeline at its end: %d", left)
+	}
+}
+
+// TestGroupReverse validates that a reversing group plays its timelines
+// backward in the opposite order, emitting their events in that order.
+func TestGroupReverse(t *testing.T) {
+	clock := govfx.NewManualClock(time.Unix(0, 0))
+	group, events := newTestGroup(t, govfx.Stat{Clock: clock, Reverse: true})
+
+	group.Start()
+	clock.Frames(150, time.Second/60)
+
+	if left := leftOf("#second"); left == 100 || left == 0 {
+		t.Fatalf("Should have played the second timeline backward: %d", left)
+	}
+
+	for i := 0; i < 200 && clock.Loops() > 0; i++ {
+		clock.Step(time.Second / 60)
+	}
+
+	expectEvents(t, *events,
+		"group-begin", "first-begin", "second-begin", "first-end", "second-end",
+		"second-begin", "first-begin", "second-end", "first-end", "group-end",
+	)
+
+	if first, second := leftOf("#first"), leftOf("#second"); first != 0 || second != 0 {
+		t.Fatalf("Should have reversed both timelines to their start: %d and %d", first, second)
+	}
+}
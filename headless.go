@@ -0,0 +1,582 @@
+package govfx
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+//==============================================================================
+
+// HeadlessDefaults defines the initial computed values used by the headless
+// style engine for properties not set by any rule or inline style.
+var HeadlessDefaults = map[string]string{
+	"display":          "block",
+	"position":         "static",
+	"top":              "0px",
+	"left":             "0px",
+	"width":            "0px",
+	"height":           "0px",
+	"margin-top":       "0px",
+	"margin-left":      "0px",
+	"margin-right":     "0px",
+	"margin-bottom":    "0px",
+	"opacity":          "1",
+	"color":            "rgb(0, 0, 0)",
+	"background-color": "rgba(0, 0, 0, 0)",
+	"border-color":     "currentcolor",
+	"outline-color":    "currentcolor",
+	"font-size":        "16px",
+	"transform":        "none",
+}
+
+// HeadlessViewportWidth and HeadlessViewportHeight define the initial size of
+// the viewport of a headless document.
+const (
+	HeadlessViewportWidth  = 1024
+	HeadlessViewportHeight = 768
+)
+
+// headlessInherited defines the properties which the headless style engine
+// inherits from the parent element when not set on the element itself.
+var headlessInherited = map[string]bool{
+	"color":     true,
+	"font-size": true,
+}
+
+//==============================================================================
+
+// Headless defines an in-memory document which implements the Backend
+// interface. It provides a fake style engine where the computed style of an
+// element is resolved from the defaults, the rules added to the document and
+// finally the element's inline style attribute. This allows animations to be
+// run and tested outside of the browser.
+type Headless struct {
+	rl       sync.RWMutex
+	body     *MemoryElement
+	rules    []headlessRule
+	defaults map[string]string
+	width    float64
+	height   float64
+}
+
+// headlessRule defines a stylesheet rule within the headless document.
+type headlessRule struct {
+	selector string
+	decls    []styleDecl
+}
+
+// NewHeadless returns a new instance of the Headless document.
+func NewHeadless() *Headless {
+	h := Headless{
+		defaults: make(map[string]string),
+		width:    HeadlessViewportWidth,
+		height:   HeadlessViewportHeight,
+	}
+
+	for key, val := range HeadlessDefaults {
+		h.defaults[key] = val
+	}
+
+	h.body = h.CreateElement("body")
+	return &h
+}
+
+// Body returns the root element of the headless document.
+func (h *Headless) Body() *MemoryElement {
+	return h.body
+}
+
+// CreateElement returns a new detached element for the document.
+func (h *Headless) CreateElement(tag string) *MemoryElement {
+	em := MemoryElement{
+		doc:   h,
+		tag:   strings.ToLower(tag),
+		attrs: make(map[string]string),
+	}
+
+	return &em
+}
+
+// SetDefault sets the default computed value for the giving property.
+func (h *Headless) SetDefault(prop string, value string) {
+	h.rl.Lock()
+	defer h.rl.Unlock()
+	h.defaults[prop] = value
+}
+
+// SetViewport sets the width and height of the viewport of the document.
+func (h *Headless) SetViewport(width float64, height float64) {
+	h.rl.Lock()
+	defer h.rl.Unlock()
+	h.width = width
+	h.height = height
+}
+
+// AddRule adds a stylesheet rule with the provided declarations text
+// (eg "width: 20px; height: 40px") to the document.
+func (h *Headless) AddRule(selector string, decls string) {
+	h.rl.Lock()
+	defer h.rl.Unlock()
+	h.rules = append(h.rules, headlessRule{
+		selector: selector,
+		decls:    parseStyleText(decls),
+	})
+}
+
+// QuerySelector returns the first element matching the selector else nil.
+func (h *Headless) QuerySelector(selector string) DOMElement {
+	items := h.QuerySelectorAll(selector)
+	if len(items) == 0 {
+		return nil
+	}
+
+	return items[0]
+}
+
+// QuerySelectorAll returns all elements in document order that match the
+// selector. Only simple selectors (tag, #id, .class, combinations of them and
+// comma delimited lists) are supported.
+func (h *Headless) QuerySelectorAll(selector string) []DOMElement {
+	var elems []DOMElement
+
+	h.body.walk(func(em *MemoryElement) {
+		if em.Matches(selector) {
+			elems = append(elems, em)
+		}
+	})
+
+	return elems
+}
+
+// ComputedStyle returns the computed style map for the element.
+func (h *Headless) ComputedStyle(elem DOMElement, pseudo string) (ComputedStyleMap, error) {
+	em, ok := unwrapElement(elem).(*MemoryElement)
+	if !ok {
+		return nil, ErrInvalidElement
+	}
+
+	styleMap := make(ComputedStyleMap)
+
+	for key, val := range h.computed(em) {
+		styleMap[key] = newComputedStyle(key, key, val.Value, val.Priority)
+	}
+
+	return styleMap, nil
+}
+
+// ComputedProperty returns the computed style of the single property of the
+// element.
+func (h *Headless) ComputedProperty(elem DOMElement, pseudo string, prop string) (*ComputedStyle, error) {
+	em, ok := unwrapElement(elem).(*MemoryElement)
+	if !ok {
+		return nil, ErrInvalidElement
+	}
+
+	val, ok := h.computed(em)[prop]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return newComputedStyle(prop, prop, val.Value, val.Priority), nil
+}
+
+// BoundingRect returns the bounding rect of the element using its computed
+// top, left, width and height with the offsets of its parents.
+func (h *Headless) BoundingRect(elem DOMElement) Rect {
+	em, ok := unwrapElement(elem).(*MemoryElement)
+	if !ok {
+		return Rect{}
+	}
+
+	var rect Rect
+
+	for cur := em; cur != nil; cur = cur.parent {
+		styles := h.computed(cur)
+		rect.Top += ParseFloat(styles["top"].Value)
+		rect.Left += ParseFloat(styles["left"].Value)
+	}
+
+	styles := h.computed(em)
+	rect.Width = ParseFloat(styles["width"].Value)
+	rect.Height = ParseFloat(styles["height"].Value)
+	rect.Right = rect.Left + rect.Width
+	rect.Bottom = rect.Top + rect.Height
+
+	return rect
+}
+
+// Parent returns the parent element of the element else nil if it has none.
+func (h *Headless) Parent(elem DOMElement) DOMElement {
+	em, ok := unwrapElement(elem).(*MemoryElement)
+	if !ok || em.parent == nil {
+		return nil
+	}
+
+	return em.parent
+}
+
+// Viewport returns the width and height of the viewport of the document.
+func (h *Headless) Viewport() (float64, float64) {
+	h.rl.RLock()
+	defer h.rl.RUnlock()
+	return h.width, h.height
+}
+
+// StyleProperty returns the inline value of the property on the element and
+// whether it is important, where a empty value means it is not set.
+func (h *Headless) StyleProperty(elem DOMElement, prop string) (string, bool) {
+	em, ok := unwrapElement(elem).(*MemoryElement)
+	if !ok {
+		return "", false
+	}
+
+	return em.StyleProperty(prop)
+}
+
+// SetStyleProperty sets the inline value of the property on the element,
+// leaving its other inline declarations as is.
+func (h *Headless) SetStyleProperty(elem DOMElement, prop string, value string, important bool) {
+	if em, ok := unwrapElement(elem).(*MemoryElement); ok {
+		em.SetStyleProperty(prop, value, important)
+	}
+}
+
+// RemoveStyleProperty removes the inline value of the property on the element.
+func (h *Headless) RemoveStyleProperty(elem DOMElement, prop string) {
+	if em, ok := unwrapElement(elem).(*MemoryElement); ok {
+		em.RemoveStyleProperty(prop)
+	}
+}
+
+// ObserveStyle calls the function whenever the class or style attribute of the
+// element changes, returning a function which stops observing it.
+func (h *Headless) ObserveStyle(elem DOMElement, fn func()) func() {
+	em, ok := unwrapElement(elem).(*MemoryElement)
+	if !ok {
+		return nil
+	}
+
+	return em.Observe(fn)
+}
+
+// computed resolves the declarations applying to the element.
+func (h *Headless) computed(em *MemoryElement) map[string]styleDecl {
+	h.rl.RLock()
+	defer h.rl.RUnlock()
+	return h.resolve(em)
+}
+
+// resolve resolves the declarations applying to the element, expecting the
+// document lock to be held by the caller.
+func (h *Headless) resolve(em *MemoryElement) map[string]styleDecl {
+	styles := make(map[string]styleDecl)
+
+	for key, val := range h.defaults {
+		styles[key] = styleDecl{Property: key, Value: val}
+	}
+
+	if em.parent != nil {
+		parent := h.resolve(em.parent)
+
+		for key := range headlessInherited {
+			if val, ok := parent[key]; ok {
+				styles[key] = val
+			}
+		}
+	}
+
+	for _, rule := range h.rules {
+		if !em.Matches(rule.selector) {
+			continue
+		}
+
+		for _, decl := range rule.decls {
+			styles[decl.Property] = decl
+		}
+	}
+
+	for _, decl := range parseStyleText(em.GetAttribute("style")) {
+		if old, ok := styles[decl.Property]; ok && old.Priority && !decl.Priority {
+			continue
+		}
+
+		styles[decl.Property] = decl
+	}
+
+	return styles
+}
+
+//==============================================================================
+
+// MemoryElement defines an in-memory element within a Headless document.
+type MemoryElement struct {
+	rl       sync.RWMutex
+	doc      *Headless
+	tag      string
+	attrs    map[string]string
+	parent   *MemoryElement
+	children []*MemoryElement
+	watchers []*func()
+}
+
+// TagName returns the tag name of the element.
+func (m *MemoryElement) TagName() string {
+	return strings.ToUpper(m.tag)
+}
+
+// GetAttribute returns the value of the giving attribute.
+func (m *MemoryElement) GetAttribute(name string) string {
+	m.rl.RLock()
+	defer m.rl.RUnlock()
+	return m.attrs[strings.ToLower(name)]
+}
+
+// SetAttribute sets the value of the giving attribute.
+func (m *MemoryElement) SetAttribute(name string, value string) {
+	name = strings.ToLower(name)
+	defer m.notify(name)
+
+	m.rl.Lock()
+	defer m.rl.Unlock()
+	m.attrs[name] = value
+}
+
+// RemoveAttribute removes the giving attribute from the element.
+func (m *MemoryElement) RemoveAttribute(name string) {
+	name = strings.ToLower(name)
+	defer m.notify(name)
+
+	m.rl.Lock()
+	defer m.rl.Unlock()
+	delete(m.attrs, name)
+}
+
+// Observe registers the function to be called whenever the class or style
+// attribute of the element changes, as a MutationObserver would, returning a
+// function which removes it.
+func (m *MemoryElement) Observe(fn func()) func() {
+	watcher := &fn
+
+	m.rl.Lock()
+	m.watchers = append(m.watchers, watcher)
+	m.rl.Unlock()
+
+	return func() {
+		m.rl.Lock()
+		defer m.rl.Unlock()
+
+		for ind, item := range m.watchers {
+			if item == watcher {
+				m.watchers = append(m.watchers[:ind], m.watchers[ind+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// notify calls the observers of the element if the attribute is the class or
+// style attribute.
+func (m *MemoryElement) notify(name string) {
+	if name != "class" && name != "style" {
+		return
+	}
+
+	m.rl.RLock()
+	watchers := make([]*func(), len(m.watchers))
+	copy(watchers, m.watchers)
+	m.rl.RUnlock()
+
+	for _, watcher := range watchers {
+		(*watcher)()
+	}
+}
+
+// StyleProperty returns the value of the property within the style attribute
+// and whether it is important, where a empty value means it is not set.
+func (m *MemoryElement) StyleProperty(prop string) (string, bool) {
+	prop = strings.ToLower(prop)
+
+	for _, decl := range parseStyleText(m.GetAttribute("style")) {
+		if decl.Property == prop {
+			return decl.Value, decl.Priority
+		}
+	}
+
+	return "", false
+}
+
+// SetStyleProperty sets the value of the property within the style attribute
+// as style.setProperty does, replacing it in place if it is set else adding it
+// after the other declarations.
+func (m *MemoryElement) SetStyleProperty(prop string, value string, important bool) {
+	defer m.notify("style")
+
+	m.rl.Lock()
+	defer m.rl.Unlock()
+
+	prop = strings.ToLower(prop)
+	decl := styleDecl{Property: prop, Value: value, Priority: important}
+
+	decls := parseStyleText(m.attrs["style"])
+
+	var found bool
+
+	for ind := range decls {
+		if decls[ind].Property == prop {
+			decls[ind] = decl
+			found = true
+		}
+	}
+
+	if !found {
+		decls = append(decls, decl)
+	}
+
+	m.attrs["style"] = formatStyleText(decls)
+}
+
+// RemoveStyleProperty removes the property from the style attribute.
+func (m *MemoryElement) RemoveStyleProperty(prop string) {
+	defer m.notify("style")
+
+	m.rl.Lock()
+	defer m.rl.Unlock()
+
+	prop = strings.ToLower(prop)
+
+	var decls []styleDecl
+
+	for _, decl := range parseStyleText(m.attrs["style"]) {
+		if decl.Property != prop {
+			decls = append(decls, decl)
+		}
+	}
+
+	m.attrs["style"] = formatStyleText(decls)
+}
+
+// Parent returns the parent of the element else nil if detached.
+func (m *MemoryElement) Parent() *MemoryElement {
+	return m.parent
+}
+
+// Children returns the children of the element.
+func (m *MemoryElement) Children() []*MemoryElement {
+	return m.children
+}
+
+// AppendChild adds the giving element as the last child of this element,
+// removing it from its previous parent if any.
+func (m *MemoryElement) AppendChild(child *MemoryElement) {
+	if child.parent != nil {
+		child.parent.RemoveChild(child)
+	}
+
+	child.parent = m
+	m.children = append(m.children, child)
+}
+
+// RemoveChild removes the giving element from the children of this element.
+func (m *MemoryElement) RemoveChild(child *MemoryElement) {
+	for ind, item := range m.children {
+		if item != child {
+			continue
+		}
+
+		m.children = append(m.children[:ind], m.children[ind+1:]...)
+		child.parent = nil
+		return
+	}
+}
+
+// Matches returns true/false if the element matches the giving simple
+// selector.
+func (m *MemoryElement) Matches(selector string) bool {
+	for _, sel := range strings.Split(selector, ",") {
+		if m.matchCompound(strings.TrimSpace(sel)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchCompound matches the element against a compound selector such as
+// "div.item#first".
+func (m *MemoryElement) matchCompound(sel string) bool {
+	if sel == "" {
+		return false
+	}
+
+	if sel == "*" {
+		return true
+	}
+
+	classes := strings.Fields(m.GetAttribute("class"))
+	id := m.GetAttribute("id")
+
+	for _, part := range splitCompound(sel) {
+		switch part[0] {
+		case '#':
+			if part[1:] != id {
+				return false
+			}
+		case '.':
+			if !hasString(classes, part[1:]) {
+				return false
+			}
+		default:
+			if strings.ToLower(part) != m.tag {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// walk calls the function for this element and all its descendants in
+// document order.
+func (m *MemoryElement) walk(fn func(*MemoryElement)) {
+	fn(m)
+
+	for _, child := range m.children {
+		child.walk(fn)
+	}
+}
+
+// String returns a readable representation of the element.
+func (m *MemoryElement) String() string {
+	return fmt.Sprintf("<%s id=%q class=%q style=%q>", m.tag, m.GetAttribute("id"), m.GetAttribute("class"), m.GetAttribute("style"))
+}
+
+//==============================================================================
+
+// splitCompound splits a compound selector into its tag, id and class parts.
+func splitCompound(sel string) []string {
+	var parts []string
+	var last int
+
+	for i := 1; i < len(sel); i++ {
+		if sel[i] == '#' || sel[i] == '.' {
+			parts = append(parts, sel[last:i])
+			last = i
+		}
+	}
+
+	return append(parts, sel[last:])
+}
+
+// hasString returns true/false if the item exists in the list.
+func hasString(list []string, item string) bool {
+	for _, val := range list {
+		if val == item {
+			return true
+		}
+	}
+
+	return false
+}
+
+//==============================================================================
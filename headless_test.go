@@ -0,0 +1,83 @@
+package govfx_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influx6/govfx"
+	_ "github.com/influx6/govfx/animators"
+)
+
+// newDocument returns a headless document with a list of elements set as the
+// current backend.
+func newDocument(count int) *govfx.Headless {
+	doc := govfx.NewHeadless()
+	doc.AddRule(".item", "width: 100px; height: 50px")
+
+	for i := 0; i < count; i++ {
+		item := doc.CreateElement("div")
+		item.SetAttribute("class", "item")
+		doc.Body().AppendChild(item)
+	}
+
+	govfx.UseBackend(doc)
+	return doc
+}
+
+// TestHeadlessStyles validates the behaviour of the headless style engine.
+func TestHeadlessStyles(t *testing.T) {
+	doc := newDocument(2)
+
+	doc.Body().SetAttribute("style", "font-size: 20px")
+
+	items := govfx.QuerySelectorAll("div.item")
+	if len(items) != 2 {
+		t.Fatalf("Should have selected 2 elements but got %d", len(items))
+	}
+
+	if width, _, _ := items[0].ReadInt("width", ""); width != 100 {
+		t.Fatalf("Should have read width of 100 from rule but got %d", width)
+	}
+
+	if size, _, _ := items[0].ReadInt("font-size", ""); size != 20 {
+		t.Fatalf("Should have inherited font-size of 20 but got %d", size)
+	}
+
+	items[1].SetAttribute("style", "width: 300px !important")
+
+	width, priority, _ := govfx.NewElement(items[1], "").ReadInt("width", "")
+	if width != 300 || !priority {
+		t.Fatalf("Should have read important inline width of 300 but got %d", width)
+	}
+
+	top, right, _, _ := govfx.BoundingBox(items[1])
+	if top != 0 || right != 300 {
+		t.Fatalf("Should have a bounding box of 300 width but got %.2f", right)
+	}
+}
+
+// TestHeadlessSequence validates the rendering of sequences into a headless
+// document.
+func TestHeadlessSequence(t *testing.T) {
+	newDocument(3)
+
+	items := govfx.QuerySelectorAll(".item")
+	frame, err := govfx.NewSeqBev(items, govfx.Stat{
+		Duration: 1 * time.Second,
+	}, govfx.Values{
+		{"value": 500, "animate": "width", "easing": "linear"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	frame.Update(0.01, 0, 0.5)
+	frame.Render(0)
+
+	for _, item := range items {
+		if !strings.Contains(item.GetAttribute("style"), "width:") {
+			t.Fatalf("Should have written width into style attribute: %q", item.GetAttribute("style"))
+		}
+	}
+}
@@ -13,6 +13,10 @@ import (
 // ErrNotFound provides a not found error used when a property was not found.
 var ErrNotFound = errors.New("Not Found")
 
+// ErrInvalidElement provides an error used when a element is not supported by
+// the current backend.
+var ErrInvalidElement = errors.New("Invalid Element")
+
 //==============================================================================
 
 // GetProp retrieves the necessary property for this specific name.
@@ -0,0 +1,308 @@
+package govfx
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+//==============================================================================
+
+// KeyframesAttributeName defines the property used to provide a list of
+// keyframes to a Animate item instead of a single value.
+const KeyframesAttributeName = "keyframes"
+
+// KeyframeAtAttributeName defines the property used to provide the position
+// of a keyframe between 0 and 1 of the timeline.
+const KeyframeAtAttributeName = "at"
+
+// ErrInvalidKeyframes defines the error returned when the keyframes provided
+// are not a list of values.
+var ErrInvalidKeyframes = errors.New("Invalid Keyframes")
+
+//==============================================================================
+
+// Keyframes defines a sequence which animates through multiple stops, where
+// each stop is handled by its own sequence of the animator, started from the
+// state of the previous stop. As with css keyframes, the easing of a keyframe
+// applies to the segment from that keyframe to the next one.
+type Keyframes struct {
+	stops []float64
+	seqs  []Sequence
+
+	active int
+	local  float64
+}
+
+// keyframe defines a single stop of a keyframes list.
+type keyframe struct {
+	at    float64
+	value Value
+}
+
+// NewKeyframes returns a new keyframes sequence for the animator of the giving
+// name, using the list of keyframes with the values map for all other
+// properties.
+func NewKeyframes(name string, m Value, frames interface{}) (Sequence, error) {
+	list, ok := toValues(frames)
+	if !ok || len(list) == 0 {
+		return nil, ErrInvalidKeyframes
+	}
+
+	var kfs []keyframe
+
+	for _, frame := range list {
+		kfs = append(kfs, keyframe{
+			at:    keyframeAt(frame[KeyframeAtAttributeName]),
+			value: frame,
+		})
+	}
+
+	sort.SliceStable(kfs, func(i, j int) bool {
+		return kfs[i].at < kfs[j].at
+	})
+
+	var k Keyframes
+
+	// Each segment into a stop is eased by the easing of the stop before it.
+	easing := m["easing"]
+
+	for _, kf := range kfs {
+		vals := CloneValue(m)
+		delete(vals, KeyframesAttributeName)
+
+		for key, val := range kf.value {
+			if key == KeyframeAtAttributeName {
+				continue
+			}
+
+			vals[key] = val
+		}
+
+		if easing != nil {
+			vals["easing"] = easing
+		} else {
+			delete(vals, "easing")
+		}
+
+		if next, ok := kf.value["easing"]; ok {
+			easing = next
+		}
+
+		seq, err := NewSequence(name, vals)
+		if err != nil {
+			return nil, err
+		}
+
+		k.stops = append(k.stops, kf.at)
+		k.seqs = append(k.seqs, seq)
+	}
+
+	return &k, nil
+}
+
+// Init initializes each stop's sequence, where every sequence is initialized
+// with the final state of the sequence of the stop before it.
+func (k *Keyframes) Init(elem Elemental) error {
+	var target Elemental = elem
+
+	for _, seq := range k.seqs {
+		if err := seq.Init(target); err != nil {
+			return err
+		}
+
+		seekSequence(seq, 0, 1)
+
+		target = newKeyframeElement(elem, DeclarationsOf(seq))
+	}
+
+	k.active = 0
+	return nil
+}
+
+// Update updates the sequence of the segment containing the timeline
+// progress, using the progress within that segment.
+func (k *Keyframes) Update(delta float64, timeline float64) {
+	k.seqs[k.segment(timeline)].Update(delta, k.local)
+}
+
+// Seek implements the Seekable interface and seeks the sequence of the segment
+// containing the timeline progress to the progress within that segment.
+func (k *Keyframes) Seek(timeline float64) {
+	seekSequence(k.seqs[k.segment(timeline)], 0, k.local)
+}
+
+// segment sets the active segment to the one containing the timeline progress
+// along with the progress within that segment, returning the active segment.
+func (k *Keyframes) segment(timeline float64) int {
+	k.active = len(k.stops) - 1
+	k.local = 1
+
+	var start float64
+
+	for ind, stop := range k.stops {
+		if timeline > stop {
+			start = stop
+			continue
+		}
+
+		k.active = ind
+
+		if stop > start {
+			k.local = (timeline - start) / (stop - start)
+		}
+
+		break
+	}
+
+	return k.active
+}
+
+// Blend blends the sequence of the active segment.
+func (k *Keyframes) Blend(interpolate float64) {
+	if bem, ok := k.seqs[k.active].(Blending); ok {
+		bem.Blend(interpolate)
+	}
+}
+
+// Reset resets the sequences of all segments.
+func (k *Keyframes) Reset() {
+	for _, seq := range k.seqs {
+		if rem, ok := seq.(Resetable); ok {
+			rem.Reset()
+		}
+	}
+
+	k.active = 0
+}
+
+// CSS writes the css output of the sequence of the active segment.
+func (k *Keyframes) CSS(w io.Writer) {
+	k.seqs[k.active].CSS(w)
+}
+
+// Declare implements the Declarer interface and emits the declarations of the
+// sequence of the active segment.
+func (k *Keyframes) Declare(decls *Declarations) {
+	for _, decl := range DeclarationsOf(k.seqs[k.active]) {
+		decls.Set(decl)
+	}
+}
+
+//==============================================================================
+
+// keyframeElement defines a Elemental which reports the properties written by
+// a previous keyframe, allowing the next keyframe to start from its state.
+type keyframeElement struct {
+	Elemental
+	props Declarations
+}
+
+// newKeyframeElement returns a new keyframeElement for the element using the
+// provided declarations as its overriding properties.
+func newKeyframeElement(elem Elemental, decls Declarations) *keyframeElement {
+	ke := keyframeElement{Elemental: elem, props: decls}
+	return &ke
+}
+
+// Read returns the property written by the previous keyframe else reads it
+// from the element.
+func (k *keyframeElement) Read(prop string, selector string) (string, bool, bool) {
+	decl, ok := k.props.Get(prop)
+	if !ok {
+		return k.Elemental.Read(prop, selector)
+	}
+
+	cs := newComputedStyle(prop, prop, decl.CSSValue(), decl.Important)
+
+	value, found := selectValue(cs, selector)
+	return value, decl.Important, found
+}
+
+// Unwrap returns the element the keyframe element reports the properties of,
+// which is the element used to resolve the context of relative lengths.
+func (k *keyframeElement) Unwrap() Elemental {
+	return k.Elemental
+}
+
+// ReadInt reads the given property and attempts to convert its value into a
+// int type else returns 0 as that value type.
+func (k *keyframeElement) ReadInt(prop string, sel string) (int, bool, bool) {
+	val, po, ok := k.Read(prop, sel)
+	return ParseInt(val), po, ok
+}
+
+// ReadFloat reads the given property and attempts to convert its value into a
+// float64 type else returns 0 as that value type.
+func (k *keyframeElement) ReadFloat(prop string, sel string) (float64, bool, bool) {
+	val, po, ok := k.Read(prop, sel)
+	return ParseFloat(val), po, ok
+}
+
+//==============================================================================
+
+// keyframeAt returns the position of a keyframe from the provided value, which
+// can either be a number of any numeric type between 0 and 1 or a percentage
+// string.
+func keyframeAt(val interface{}) float64 {
+	var at float64
+
+	if mo, ok := val.(string); ok {
+		at = ParseFloat(mo)
+		if strings.HasSuffix(strings.TrimSpace(mo), "%") {
+			at = at / 100
+		}
+	} else if rv := reflect.ValueOf(val); rv.IsValid() && isNumeric(rv.Kind()) {
+		at = rv.Convert(float64Type).Float()
+	}
+
+	if at < 0 {
+		return 0
+	}
+
+	if at > 1 {
+		return 1
+	}
+
+	return at
+}
+
+// toValues returns the provided value as a Values list if it is one of the
+// supported list types.
+func toValues(val interface{}) (Values, bool) {
+	switch mo := val.(type) {
+	case Values:
+		return mo, true
+	case []Value:
+		return Values(mo), true
+	case []map[string]interface{}:
+		var vals Values
+
+		for _, item := range mo {
+			vals = append(vals, Value(item))
+		}
+
+		return vals, true
+	case []interface{}:
+		var vals Values
+
+		for _, item := range mo {
+			switch im := item.(type) {
+			case Value:
+				vals = append(vals, im)
+			case map[string]interface{}:
+				vals = append(vals, Value(im))
+			default:
+				return nil, false
+			}
+		}
+
+		return vals, true
+	}
+
+	return nil, false
+}
+
+//==============================================================================
@@ -0,0 +1,121 @@
+package govfx_test
+
+import (
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/influx6/govfx"
+)
+
+// offset defines a sequence animating the left property from its current
+// value towards the target.
+type offset struct {
+	Target float64 `govfx:"value"`
+	Easing string  `govfx:"easing"`
+
+	from    float64
+	current float64
+}
+
+func (o *offset) Init(elem govfx.Elemental) error {
+	o.from, _, _ = elem.ReadFloat("left", "")
+	o.current = o.from
+	return nil
+}
+
+func (o *offset) Update(delta float64, timeline float64) {
+	o.current = o.from + (o.Target-o.from)*govfx.GetEasing(o.Easing).Ease(timeline)
+}
+
+func (o *offset) CSS(w io.Writer) {
+	fmt.Fprintf(w, "left: %.0fpx", o.current)
+}
+
+func init() {
+	govfx.RegisterSequence("test-offset", offset{})
+}
+
+// TestKeyframes validates the behaviour of multi-stop keyframes sequences.
+func TestKeyframes(t *testing.T) {
+	newDocument(1)
+
+	items := govfx.QuerySelectorAll(".item")
+	frame, err := govfx.NewSeqBev(items, govfx.Stat{
+		Duration: 1 * time.Second,
+	}, govfx.Values{
+		{
+			"animate": "test-offset",
+			"easing":  "linear",
+			"keyframes": []interface{}{
+				map[string]interface{}{"at": 1, "value": 250.0},
+				map[string]interface{}{"at": 0, "value": 100.0},
+				map[string]interface{}{"at": "30%", "value": 400.0, "easing": "ease-in"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []struct {
+		at   float64
+		left int
+	}{
+		{0, 100},
+		{0.15, 250},
+		{0.3, 400},
+		{1, 250},
+	}
+
+	for _, item := range expected {
+		frame.Update(0.01, item.at, item.at)
+		frame.Render(0)
+
+		if left := govfx.ParseInt(items[0].GetAttribute("style")); left != item.left {
+			t.Fatalf("Should have left of %d at %.2f but got %d", item.left, item.at, left)
+		}
+	}
+
+	// The segment after the 30% keyframe uses its ease-in easing.
+	frame.Update(0.01, 0.65, 0.65)
+	frame.Render(0)
+
+	if left := govfx.ParseInt(items[0].GetAttribute("style")); left == 325 || left < 250 || left > 400 {
+		t.Fatalf("Should have eased the last segment with ease-in but got %d", left)
+	}
+}
+
+// TestKeyframesContext validates that every segment of keyframes resolves its
+// relative lengths within the context of the element.
+func TestKeyframesContext(t *testing.T) {
+	doc := newDocument(1)
+	doc.Body().SetAttribute("style", "width: 400px")
+
+	items := govfx.QuerySelectorAll(".item")
+	timeline, err := govfx.Animate(govfx.Stat{
+		Duration: 1 * time.Second,
+		Clock:    govfx.NewManualClock(time.Unix(0, 0)),
+	}, govfx.Values{
+		{
+			"animate": "width",
+			"easing":  "linear",
+			"keyframes": []interface{}{
+				map[string]interface{}{"at": int64(0), "value": "25%"},
+				map[string]interface{}{"at": uint(1), "value": "300px"},
+				map[string]interface{}{"at": float32(0.5), "value": "50%"},
+			},
+		},
+	}, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The last segment moves from 50% of the body (200px) towards 300px.
+	timeline.SeekProgress(0.75)
+
+	if style := items[0].GetAttribute("style"); style != "width: 250px" {
+		t.Fatalf("Should have resolved the last segment against the body: %q", style)
+	}
+}
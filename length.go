@@ -0,0 +1,549 @@
+package govfx
+
+import (
+	"errors"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+//==============================================================================
+
+// ErrInvalidLength defines the error returned when a length value can not be
+// parsed.
+var ErrInvalidLength = errors.New("Invalid Length")
+
+// absoluteUnits defines the pixel size of the absolute css length units.
+var absoluteUnits = map[string]float64{
+	"px": 1,
+	"in": 96,
+	"cm": 96 / 2.54,
+	"mm": 96 / 25.4,
+	"q":  96 / 101.6,
+	"pt": 96.0 / 72,
+	"pc": 16,
+}
+
+// relativeUnits defines the css length units which resolve against the
+// context of the element.
+var relativeUnits = map[string]bool{
+	"%":    true,
+	"em":   true,
+	"rem":  true,
+	"vw":   true,
+	"vh":   true,
+	"vmin": true,
+	"vmax": true,
+}
+
+// IsLengthUnit returns true/false if the unit is a known css length unit.
+func IsLengthUnit(unit string) bool {
+	unit = strings.ToLower(unit)
+	return absoluteUnits[unit] != 0 || relativeUnits[unit]
+}
+
+//==============================================================================
+
+// LengthContext defines the sizes of the context of an element which relative
+// lengths are resolved against.
+type LengthContext struct {
+	Percent        float64 // size a percentage is taken of, eg the parent width.
+	FontSize       float64 // font size in pixels em values resolve against.
+	RootFontSize   float64 // font size in pixels rem values resolve against.
+	ViewportWidth  float64
+	ViewportHeight float64
+}
+
+// unitSize returns the size in pixels of one of the provided unit within the
+// context.
+func (c LengthContext) unitSize(unit string) (float64, bool) {
+	if size, ok := absoluteUnits[unit]; ok {
+		return size, true
+	}
+
+	switch unit {
+	case "%":
+		return c.Percent / 100, true
+	case "em":
+		return c.FontSize, true
+	case "rem":
+		return c.RootFontSize, true
+	case "vw":
+		return c.ViewportWidth / 100, true
+	case "vh":
+		return c.ViewportHeight / 100, true
+	case "vmin":
+		if c.ViewportWidth < c.ViewportHeight {
+			return c.ViewportWidth / 100, true
+		}
+
+		return c.ViewportHeight / 100, true
+	case "vmax":
+		if c.ViewportWidth > c.ViewportHeight {
+			return c.ViewportWidth / 100, true
+		}
+
+		return c.ViewportHeight / 100, true
+	}
+
+	return 0, false
+}
+
+// initialFontSize defines the font size in pixels of the css medium keyword,
+// which is the initial font size of a document.
+const initialFontSize = 16
+
+// heightRelative defines the properties whose percentages resolve against the
+// height of the parent instead of its width.
+var heightRelative = map[string]bool{
+	"height":     true,
+	"min-height": true,
+	"max-height": true,
+	"top":        true,
+	"bottom":     true,
+}
+
+// ContextOf returns the context the lengths of the property of the element
+// resolve against using the current backend. Percentages resolve against the
+// parent's width or height (the viewport for the root element) depending on
+// the property, while font-size resolves its percentages and em values
+// against the font size of the parent.
+func ContextOf(elem DOMElement, prop string) LengthContext {
+	b := GetBackend()
+
+	var ctx LengthContext
+	ctx.ViewportWidth, ctx.ViewportHeight = b.Viewport()
+
+	// The root element resolves its rem values against the initial font size,
+	// this also ensures the lookups below only ever walk up the ancestors.
+	parent := b.Parent(elem)
+
+	ctx.RootFontSize = initialFontSize
+	if parent != nil {
+		ctx.RootFontSize = fontSizeOf(b, rootOf(b, elem))
+	}
+
+	if prop == "font-size" {
+		ctx.FontSize = ctx.RootFontSize
+		if parent != nil {
+			ctx.FontSize = fontSizeOf(b, parent)
+		}
+
+		ctx.Percent = ctx.FontSize
+		return ctx
+	}
+
+	ctx.FontSize = fontSizeOf(b, elem)
+
+	dimension := "width"
+	if heightRelative[prop] {
+		dimension = "height"
+	}
+
+	if parent == nil {
+		ctx.Percent = ctx.ViewportWidth
+		if dimension == "height" {
+			ctx.Percent = ctx.ViewportHeight
+		}
+
+		return ctx
+	}
+
+	ctx.Percent = lengthOf(b, parent, dimension)
+	return ctx
+}
+
+// rootOf returns the top most ancestor of the element.
+func rootOf(b Backend, elem DOMElement) DOMElement {
+	for parent := b.Parent(elem); parent != nil; parent = b.Parent(elem) {
+		elem = parent
+	}
+
+	return elem
+}
+
+// computedValue returns the computed value of the property of the element.
+func computedValue(b Backend, elem DOMElement, prop string) string {
+	cs, err := b.ComputedProperty(elem, "", prop)
+	if err != nil {
+		return ""
+	}
+
+	return cs.Value
+}
+
+// lengthOf returns the computed length of the property of the element in
+// pixels.
+func lengthOf(b Backend, elem DOMElement, prop string) float64 {
+	length, err := ParseLength(computedValue(b, elem, prop))
+	if err != nil {
+		return 0
+	}
+
+	if length.IsAbsolute() {
+		return length.Pixels(LengthContext{})
+	}
+
+	return length.Pixels(ContextOf(elem, prop))
+}
+
+// fontSizeOf returns the computed font size of the element in pixels.
+func fontSizeOf(b Backend, elem DOMElement) float64 {
+	return lengthOf(b, elem, "font-size")
+}
+
+//==============================================================================
+
+// Length defines a css length value with its unit, eg 50% or 2.5rem. A calc()
+// expression is held as the sum of its terms, each in a different unit.
+type Length struct {
+	Value float64
+	Unit  string
+	Terms []Length
+}
+
+// IsCalc returns true/false if the length is a calc() expression.
+func (l Length) IsCalc() bool {
+	return len(l.Terms) != 0
+}
+
+// IsAbsolute returns true/false if the length does not depend on the context
+// of a element.
+func (l Length) IsAbsolute() bool {
+	if !l.IsCalc() {
+		return !relativeUnits[l.Unit]
+	}
+
+	for _, term := range l.Terms {
+		if !term.IsAbsolute() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Pixels returns the length in pixels resolved within the context, where a
+// unitless length is taken as pixels.
+func (l Length) Pixels(ctx LengthContext) float64 {
+	if l.IsCalc() {
+		var total float64
+
+		for _, term := range l.Terms {
+			total += term.Pixels(ctx)
+		}
+
+		return total
+	}
+
+	if l.Unit == "" {
+		return l.Value
+	}
+
+	size, _ := ctx.unitSize(l.Unit)
+	return l.Value * size
+}
+
+// String returns the length in css format.
+func (l Length) String() string {
+	if !l.IsCalc() {
+		return formatNumber(l.Value) + l.Unit
+	}
+
+	expr := "calc(" + l.Terms[0].String()
+
+	for _, term := range l.Terms[1:] {
+		if term.Value < 0 {
+			term.Value = -term.Value
+			expr += " - " + term.String()
+			continue
+		}
+
+		expr += " + " + term.String()
+	}
+
+	return expr + ")"
+}
+
+// ToUnit returns the length resolved within the context into the provided
+// unit. A unit which can not be resolved, eg a percentage without a parent
+// size, returns the length in pixels.
+func (l Length) ToUnit(unit string, ctx LengthContext) Length {
+	return FromPixels(l.Pixels(ctx), unit, ctx)
+}
+
+// FromPixels returns a length in the provided unit from the pixel value
+// resolved within the context. A unit which can not be resolved returns the
+// length in pixels.
+func FromPixels(px float64, unit string, ctx LengthContext) Length {
+	unit = strings.ToLower(unit)
+
+	size, ok := ctx.unitSize(unit)
+	if !ok || size == 0 {
+		return Length{Value: px, Unit: "px"}
+	}
+
+	return Length{Value: px / size, Unit: unit}
+}
+
+// formatNumber returns the number with at most 4 decimal places.
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(math.Round(value*1e4)/1e4, 'f', -1, 64)
+}
+
+//==============================================================================
+
+// lengthMatch defines a matcher for a number with its unit at the start of a
+// calc() expression.
+var lengthMatch = regexp.MustCompile("^[-+]?(\\d+\\.?\\d*|\\.\\d+)([eE][-+]?\\d+)?(%|[a-zA-Z]+)?")
+
+// ParseLength parses a css length value (eg 20px, 50%, 2.5rem, 30vh) or a
+// calc() expression of lengths (eg calc(100% - 20px)). A unitless value is
+// taken as pixels.
+func ParseLength(value string) (Length, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+
+	if !strings.HasPrefix(value, "calc(") {
+		match := lengthMatch.FindStringSubmatch(value)
+		if match == nil || len(match[0]) != len(value) {
+			return Length{}, ErrInvalidLength
+		}
+
+		return newLength(match)
+	}
+
+	p := lengthParser{value: value}
+
+	sum, err := p.expr()
+	if err != nil {
+		return Length{}, err
+	}
+
+	if p.skip(); p.pos != len(p.value) {
+		return Length{}, ErrInvalidLength
+	}
+
+	return sum.length()
+}
+
+// newLength returns the length of the matched number and its unit.
+func newLength(match []string) (Length, error) {
+	num, err := strconv.ParseFloat(match[1]+match[2], 64)
+	if err != nil {
+		return Length{}, ErrInvalidLength
+	}
+
+	if strings.HasPrefix(match[0], "-") {
+		num = -num
+	}
+
+	if match[3] != "" && !IsLengthUnit(match[3]) {
+		return Length{}, ErrInvalidLength
+	}
+
+	return Length{Value: num, Unit: match[3]}, nil
+}
+
+// lengthSum defines a sum of lengths within a calc() expression, keyed by
+// their unit in the order they first appeared. A plain number is keyed by an
+// empty unit.
+type lengthSum struct {
+	units  []string
+	values map[string]float64
+}
+
+// add adds the value in the unit to the sum.
+func (s *lengthSum) add(unit string, value float64) {
+	if s.values == nil {
+		s.values = make(map[string]float64)
+	}
+
+	if _, ok := s.values[unit]; !ok {
+		s.units = append(s.units, unit)
+	}
+
+	s.values[unit] += value
+}
+
+// number returns the value of the sum if it is a plain number.
+func (s lengthSum) number() (float64, bool) {
+	if len(s.units) != 1 || s.units[0] != "" {
+		return 0, false
+	}
+
+	return s.values[""], true
+}
+
+// scale returns the sum with all its values multiplied by the factor.
+func (s lengthSum) scale(factor float64) lengthSum {
+	var scaled lengthSum
+
+	for _, unit := range s.units {
+		scaled.add(unit, s.values[unit]*factor)
+	}
+
+	return scaled
+}
+
+// length returns the sum as a length, which is a calc() expression when it
+// holds more than one unit.
+func (s lengthSum) length() (Length, error) {
+	if len(s.units) == 0 {
+		return Length{}, ErrInvalidLength
+	}
+
+	if len(s.units) == 1 {
+		return Length{Value: s.values[s.units[0]], Unit: s.units[0]}, nil
+	}
+
+	var calc Length
+
+	for _, unit := range s.units {
+		if unit == "" {
+			return Length{}, ErrInvalidLength
+		}
+
+		calc.Terms = append(calc.Terms, Length{Value: s.values[unit], Unit: unit})
+	}
+
+	return calc, nil
+}
+
+// lengthParser defines a recursive descent parser for calc() expressions.
+type lengthParser struct {
+	value string
+	pos   int
+}
+
+// skip moves the parser past any whitespace.
+func (p *lengthParser) skip() {
+	for p.pos < len(p.value) && strings.ContainsRune(" \t\n", rune(p.value[p.pos])) {
+		p.pos++
+	}
+}
+
+// peek returns the next non whitespace character else 0 at the end.
+func (p *lengthParser) peek() byte {
+	if p.skip(); p.pos < len(p.value) {
+		return p.value[p.pos]
+	}
+
+	return 0
+}
+
+// expr parses a sum or difference of terms.
+func (p *lengthParser) expr() (lengthSum, error) {
+	sum, err := p.term()
+	if err != nil {
+		return sum, err
+	}
+
+	for {
+		op := p.peek()
+		if op != '+' && op != '-' {
+			return sum, nil
+		}
+
+		p.pos++
+
+		next, err := p.term()
+		if err != nil {
+			return sum, err
+		}
+
+		if op == '-' {
+			next = next.scale(-1)
+		}
+
+		for _, unit := range next.units {
+			sum.add(unit, next.values[unit])
+		}
+	}
+}
+
+// term parses a product or division of factors, where at least one side of
+// a product and the right side of a division must be a plain number.
+func (p *lengthParser) term() (lengthSum, error) {
+	product, err := p.factor()
+	if err != nil {
+		return product, err
+	}
+
+	for {
+		op := p.peek()
+		if op != '*' && op != '/' {
+			return product, nil
+		}
+
+		p.pos++
+
+		next, err := p.factor()
+		if err != nil {
+			return product, err
+		}
+
+		num, ok := next.number()
+
+		switch {
+		case op == '/' && (!ok || num == 0):
+			return product, ErrInvalidLength
+		case op == '/':
+			product = product.scale(1 / num)
+		case ok:
+			product = product.scale(num)
+		default:
+			factor, isNum := product.number()
+			if !isNum {
+				return product, ErrInvalidLength
+			}
+
+			product = next.scale(factor)
+		}
+	}
+}
+
+// factor parses a length, a number or a parenthesized expression.
+func (p *lengthParser) factor() (lengthSum, error) {
+	var sum lengthSum
+
+	p.skip()
+
+	rest := p.value[p.pos:]
+
+	switch {
+	case strings.HasPrefix(rest, "calc("):
+		p.pos += len("calc")
+		fallthrough
+	case strings.HasPrefix(rest, "("):
+		p.pos++
+
+		inner, err := p.expr()
+		if err != nil {
+			return sum, err
+		}
+
+		if p.peek() != ')' {
+			return sum, ErrInvalidLength
+		}
+
+		p.pos++
+		return inner, nil
+	}
+
+	match := lengthMatch.FindStringSubmatch(rest)
+	if match == nil {
+		return sum, ErrInvalidLength
+	}
+
+	length, err := newLength(match)
+	if err != nil {
+		return sum, err
+	}
+
+	p.pos += len(match[0])
+	sum.add(length.Unit, length.Value)
+	return sum, nil
+}
+
+//==============================================================================
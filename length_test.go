@@ -0,0 +1,136 @@
+package govfx_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influx6/govfx"
+)
+
+// TestParseLength validates the parsing and resolution of length values.
+func TestParseLength(t *testing.T) {
+	ctx := govfx.LengthContext{
+		Percent:        400,
+		FontSize:       20,
+		RootFontSize:   16,
+		ViewportWidth:  1000,
+		ViewportHeight: 500,
+	}
+
+	expected := []struct {
+		value  string
+		css    string
+		pixels float64
+	}{
+		{"20px", "20px", 20},
+		{"-12.5", "-12.5", -12.5},
+		{"50%", "50%", 200},
+		{"2.5rem", "2.5rem", 40},
+		{"1.5em", "1.5em", 30},
+		{"30vh", "30vh", 150},
+		{"10vmax", "10vmax", 100},
+		{"1in", "1in", 96},
+		{"calc(100% - 20px)", "calc(100% - 20px)", 380},
+		{"calc(2 * (10px + 1rem) - 50%)", "calc(20px + 2rem - 50%)", -148},
+		{"calc(10vw / 2 + 5px)", "calc(5vw + 5px)", 55},
+		{"calc(3em + 2em)", "5em", 100},
+	}
+
+	for _, item := range expected {
+		length, err := govfx.ParseLength(item.value)
+		if err != nil {
+			t.Fatalf("Should have parsed %q: %s", item.value, err)
+		}
+
+		if got := length.String(); got != item.css {
+			t.Fatalf("Should have parsed %q as %q but got %q", item.value, item.css, got)
+		}
+
+		if got := length.Pixels(ctx); !near(got, item.pixels) {
+			t.Fatalf("Should have resolved %q to %.2fpx but got %.2fpx", item.value, item.pixels, got)
+		}
+	}
+
+	for _, value := range []string{"", "20parsecs", "calc(10px * 2px)", "calc(10px + 5)", "calc(10px / 0)", "calc(10px"} {
+		if _, err := govfx.ParseLength(value); err != govfx.ErrInvalidLength {
+			t.Fatalf("Should have failed to parse %q", value)
+		}
+	}
+
+	length, _ := govfx.ParseLength("200px")
+	if got := length.ToUnit("%", ctx).String(); got != "50%" {
+		t.Fatalf("Should have converted into a percentage but got %q", got)
+	}
+
+	if got := length.ToUnit("%", govfx.LengthContext{}).String(); got != "200px" {
+		t.Fatalf("Should have kept pixels without a percentage size but got %q", got)
+	}
+
+	if unit, ok := govfx.Unit("REM"); !ok || unit != "rem" {
+		t.Fatalf("Should have recognized the rem unit but got %q", unit)
+	}
+
+	for _, unit := range []string{"", "deg", "pixels"} {
+		if _, ok := govfx.Unit(unit); ok {
+			t.Fatalf("Should have rejected the unit %q", unit)
+		}
+	}
+}
+
+// TestLengthContext validates the resolution of the context of an element.
+func TestLengthContext(t *testing.T) {
+	doc := newDocument(1)
+	doc.SetViewport(800, 600)
+	doc.Body().SetAttribute("style", "width: 50%; height: 400px; font-size: 20px")
+	doc.AddRule(".item", "font-size: 1.5em")
+
+	item := govfx.QuerySelector(".item")
+
+	ctx := govfx.ContextOf(item, "width")
+	if ctx.Percent != 400 || ctx.FontSize != 30 || ctx.RootFontSize != 20 || ctx.ViewportWidth != 800 {
+		t.Fatalf("Should have resolved the context of the width: %+v", ctx)
+	}
+
+	if ctx := govfx.ContextOf(item, "top"); ctx.Percent != 400 {
+		t.Fatalf("Should have resolved percentages against the parent height: %+v", ctx)
+	}
+
+	if ctx := govfx.ContextOf(item, "font-size"); ctx.Percent != 20 || ctx.FontSize != 20 {
+		t.Fatalf("Should have resolved the font size against the parent: %+v", ctx)
+	}
+}
+
+// TestLengthAnimators validates the animation of lengths across units.
+func TestLengthAnimators(t *testing.T) {
+	doc := newDocument(1)
+	doc.Body().SetAttribute("style", "width: 400px; height: 200px")
+	doc.AddRule(".item", "width: 100px; height: 25%")
+
+	items := govfx.QuerySelectorAll(".item")
+	timeline, err := govfx.Animate(govfx.Stat{
+		Duration: 1 * time.Second,
+		Clock:    govfx.NewManualClock(time.Unix(0, 0)),
+	}, govfx.Values{
+		{"value": "50%", "animate": "width", "easing": "linear"},
+		{"value": 150, "animate": "height", "easing": "linear"},
+	}, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The width moves from 100px (25%) towards 200px (50%) in percentages, while
+	// the height moves from 25% (50px) towards 150px in pixels.
+	timeline.SeekProgress(0.5)
+
+	expected := "width: 37.5%; height: 100px"
+	if style := items[0].GetAttribute("style"); style != expected {
+		t.Fatalf("Should have interpolated the lengths:\n%q\n%q", style, expected)
+	}
+
+	timeline.SeekProgress(1)
+
+	expected = "width: 50%; height: 150px"
+	if style := items[0].GetAttribute("style"); style != expected {
+		t.Fatalf("Should have reached the target lengths:\n%q\n%q", style, expected)
+	}
+}
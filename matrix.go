@@ -0,0 +1,729 @@
+package govfx
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+//==============================================================================
+
+// ErrInvalidTransform defines the error returned when a transform value
+// contains a unknown function or can not be parsed.
+var ErrInvalidTransform = errors.New("Invalid Transform")
+
+// Matrix3D defines a 4x4 transformation matrix as used by the css transform
+// property, stored in column-major order where m[col][row] matches the order
+// of the arguments of matrix3d(). The translation is held in m[3][0..2].
+type Matrix3D [4][4]float64
+
+// IdentityMatrix returns the identity matrix.
+func IdentityMatrix() Matrix3D {
+	return Matrix3D{
+		{1, 0, 0, 0},
+		{0, 1, 0, 0},
+		{0, 0, 1, 0},
+		{0, 0, 0, 1},
+	}
+}
+
+// Multiply returns the product of the matrix with the provided matrix, which
+// applies the provided matrix before this matrix, as done for a list of
+// transform functions.
+func (m Matrix3D) Multiply(n Matrix3D) Matrix3D {
+	var res Matrix3D
+
+	for col := 0; col < 4; col++ {
+		for row := 0; row < 4; row++ {
+			for k := 0; k < 4; k++ {
+				res[col][row] += m[k][row] * n[col][k]
+			}
+		}
+	}
+
+	return res
+}
+
+// Is2D returns true/false if the matrix only contains a 2D transformation.
+func (m Matrix3D) Is2D() bool {
+	return m[0][2] == 0 && m[0][3] == 0 && m[1][2] == 0 && m[1][3] == 0 &&
+		m[2][0] == 0 && m[2][1] == 0 && m[2][2] == 1 && m[2][3] == 0 &&
+		m[3][2] == 0 && m[3][3] == 1
+}
+
+// String returns the matrix as a css matrix() value when it is 2D else as a
+// matrix3d() value.
+func (m Matrix3D) String() string {
+	if m.Is2D() {
+		return fmt.Sprintf("matrix(%s, %s, %s, %s, %s, %s)",
+			formatMatrixValue(m[0][0]), formatMatrixValue(m[0][1]),
+			formatMatrixValue(m[1][0]), formatMatrixValue(m[1][1]),
+			formatMatrixValue(m[3][0]), formatMatrixValue(m[3][1]))
+	}
+
+	var values []string
+
+	for col := 0; col < 4; col++ {
+		for row := 0; row < 4; row++ {
+			values = append(values, formatMatrixValue(m[col][row]))
+		}
+	}
+
+	return "matrix3d(" + strings.Join(values, ", ") + ")"
+}
+
+// determinant3 returns the determinant of the upper 3x3 part of the matrix.
+func (m Matrix3D) determinant3() float64 {
+	return m[0][0]*(m[1][1]*m[2][2]-m[2][1]*m[1][2]) -
+		m[1][0]*(m[0][1]*m[2][2]-m[2][1]*m[0][2]) +
+		m[2][0]*(m[0][1]*m[1][2]-m[1][1]*m[0][2])
+}
+
+// formatMatrixValue returns the value as a string rounded to six decimal places.
+func formatMatrixValue(value float64) string {
+	value = math.Round(value*1e6) / 1e6
+	if value == 0 {
+		value = 0
+	}
+
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+//==============================================================================
+
+// ParseMatrix parses a css transform value, which can either be a matrix(),
+// matrix3d() or a list of transform functions, into the matrix it describes. A
+// empty value or "none" returns the identity matrix.
+func ParseMatrix(value string) (Matrix3D, error) {
+	return parseMatrix(value, nil)
+}
+
+// ParseMatrixIn parses a css transform value into the matrix it describes as
+// ParseMatrix does, with the lengths of its translate functions (eg 50% or
+// 2em) resolved into pixels within the context.
+func ParseMatrixIn(value string, ctx TransformContext) (Matrix3D, error) {
+	return parseMatrix(value, &ctx)
+}
+
+// parseMatrix parses a css transform value into the matrix it describes,
+// resolving the lengths of its translate functions within the context if one
+// is provided.
+func parseMatrix(value string, ctx *TransformContext) (Matrix3D, error) {
+	mx := IdentityMatrix()
+
+	value = strings.TrimSpace(value)
+	if value == "" || value == "none" {
+		return mx, nil
+	}
+
+	fns := ParseTransform(value)
+	if len(fns) == 0 {
+		return mx, ErrInvalidTransform
+	}
+
+	for _, fn := range fns {
+		if ctx != nil {
+			fn = fn.Resolve(*ctx)
+		}
+
+		fm, err := fn.Matrix()
+		if err != nil {
+			return IdentityMatrix(), err
+		}
+
+		mx = mx.Multiply(fm)
+	}
+
+	return mx, nil
+}
+
+// Matrix returns the matrix of the transform function, else returns an error
+// if the function is unknown or has missing arguments.
+func (t TransformFunction) Matrix() (Matrix3D, error) {
+	mx := IdentityMatrix()
+
+	args := make([]float64, len(t.Args))
+	for ind := range t.Args {
+		args[ind] = ParseNumber(t.Args[ind])
+	}
+
+	need := func(count int) bool {
+		return len(args) >= count
+	}
+
+	switch t.Name {
+	case "matrix":
+		if !need(6) {
+			return mx, ErrInvalidTransform
+		}
+
+		mx[0][0], mx[0][1] = args[0], args[1]
+		mx[1][0], mx[1][1] = args[2], args[3]
+		mx[3][0], mx[3][1] = args[4], args[5]
+	case "matrix3d":
+		if !need(16) {
+			return mx, ErrInvalidTransform
+		}
+
+		for ind := 0; ind < 16; ind++ {
+			mx[ind/4][ind%4] = args[ind]
+		}
+	case "translate":
+		if !need(1) {
+			return mx, ErrInvalidTransform
+		}
+
+		mx[3][0] = args[0]
+		if need(2) {
+			mx[3][1] = args[1]
+		}
+	case "translateX":
+		if !need(1) {
+			return mx, ErrInvalidTransform
+		}
+
+		mx[3][0] = args[0]
+	case "translateY":
+		if !need(1) {
+			return mx, ErrInvalidTransform
+		}
+
+		mx[3][1] = args[0]
+	case "translateZ":
+		if !need(1) {
+			return mx, ErrInvalidTransform
+		}
+
+		mx[3][2] = args[0]
+	case "translate3d":
+		if !need(3) {
+			return mx, ErrInvalidTransform
+		}
+
+		mx[3][0], mx[3][1], mx[3][2] = args[0], args[1], args[2]
+	case "scale":
+		if !need(1) {
+			return mx, ErrInvalidTransform
+		}
+
+		mx[0][0], mx[1][1] = args[0], args[0]
+		if need(2) {
+			mx[1][1] = args[1]
+		}
+	case "scaleX":
+		if !need(1) {
+			return mx, ErrInvalidTransform
+		}
+
+		mx[0][0] = args[0]
+	case "scaleY":
+		if !need(1) {
+			return mx, ErrInvalidTransform
+		}
+
+		mx[1][1] = args[0]
+	case "scaleZ":
+		if !need(1) {
+			return mx, ErrInvalidTransform
+		}
+
+		mx[2][2] = args[0]
+	case "scale3d":
+		if !need(3) {
+			return mx, ErrInvalidTransform
+		}
+
+		mx[0][0], mx[1][1], mx[2][2] = args[0], args[1], args[2]
+	case "rotate", "rotateZ":
+		if !need(1) {
+			return mx, ErrInvalidTransform
+		}
+
+		return rotationMatrix(0, 0, 1, parseAngle(t.Args[0])), nil
+	case "rotateX":
+		if !need(1) {
+			return mx, ErrInvalidTransform
+		}
+
+		return rotationMatrix(1, 0, 0, parseAngle(t.Args[0])), nil
+	case "rotateY":
+		if !need(1) {
+			return mx, ErrInvalidTransform
+		}
+
+		return rotationMatrix(0, 1, 0, parseAngle(t.Args[0])), nil
+	case "rotate3d":
+		if !need(4) {
+			return mx, ErrInvalidTransform
+		}
+
+		return rotationMatrix(args[0], args[1], args[2], parseAngle(t.Args[3])), nil
+	case "skew":
+		if !need(1) {
+			return mx, ErrInvalidTransform
+		}
+
+		mx[1][0] = math.Tan(parseAngle(t.Args[0]))
+		if need(2) {
+			mx[0][1] = math.Tan(parseAngle(t.Args[1]))
+		}
+	case "skewX":
+		if !need(1) {
+			return mx, ErrInvalidTransform
+		}
+
+		mx[1][0] = math.Tan(parseAngle(t.Args[0]))
+	case "skewY":
+		if !need(1) {
+			return mx, ErrInvalidTransform
+		}
+
+		mx[0][1] = math.Tan(parseAngle(t.Args[0]))
+	case "perspective":
+		if !need(1) {
+			return mx, ErrInvalidTransform
+		}
+
+		if args[0] != 0 {
+			mx[2][3] = -1 / args[0]
+		}
+	default:
+		return mx, ErrInvalidTransform
+	}
+
+	return mx, nil
+}
+
+// rotationMatrix returns the matrix for a rotation of the angle in radians
+// around the provided axis.
+func rotationMatrix(x, y, z float64, angle float64) Matrix3D {
+	mx := IdentityMatrix()
+
+	length := math.Sqrt(x*x + y*y + z*z)
+	if length == 0 {
+		return mx
+	}
+
+	x, y, z = x/length, y/length, z/length
+
+	sc := math.Sin(angle/2) * math.Cos(angle/2)
+	sq := math.Sin(angle/2) * math.Sin(angle/2)
+
+	mx[0][0] = 1 - 2*(y*y+z*z)*sq
+	mx[0][1] = 2 * (x*y*sq + z*sc)
+	mx[0][2] = 2 * (x*z*sq - y*sc)
+	mx[1][0] = 2 * (x*y*sq - z*sc)
+	mx[1][1] = 1 - 2*(x*x+z*z)*sq
+	mx[1][2] = 2 * (y*z*sq + x*sc)
+	mx[2][0] = 2 * (x*z*sq + y*sc)
+	mx[2][1] = 2 * (y*z*sq - x*sc)
+	mx[2][2] = 1 - 2*(x*x+y*y)*sq
+
+	return mx
+}
+
+// parseAngle parses a css angle (eg 45deg, 1rad, 100grad, 0.5turn) into
+// radians, where a value without a unit is taken as degrees.
+func parseAngle(value string) float64 {
+	value = strings.TrimSpace(value)
+	num := ParseNumber(value)
+
+	switch {
+	case strings.HasSuffix(value, "grad"):
+		return num * math.Pi / 200
+	case strings.HasSuffix(value, "rad"):
+		return num
+	case strings.HasSuffix(value, "turn"):
+		return num * 2 * math.Pi
+	default:
+		return num * math.Pi / 180
+	}
+}
+
+//==============================================================================
+
+// Decomposition defines the components of a transformation matrix as defined
+// by the css transforms specification, from which the matrix can be
+// recomposed. Skew holds the xy, xz and yz shear factors and Quaternion holds
+// the rotation.
+type Decomposition struct {
+	Translate   [3]float64
+	Scale       [3]float64
+	Skew        [3]float64
+	Perspective [4]float64
+	Quaternion  [4]float64
+}
+
+// Decompose returns the decomposition of the matrix, else returns false if the
+// matrix can not be decomposed.
+func (m Matrix3D) Decompose() (Decomposition, bool) {
+	var dc Decomposition
+
+	if m[3][3] == 0 {
+		return dc, false
+	}
+
+	// Normalize the matrix.
+	for col := 0; col < 4; col++ {
+		for row := 0; row < 4; row++ {
+			m[col][row] /= m[3][3]
+		}
+	}
+
+	// The perspective matrix is used to solve for perspective and to test for
+	// the singularity of the upper 3x3 part.
+	pm := m
+	pm[0][3], pm[1][3], pm[2][3], pm[3][3] = 0, 0, 0, 1
+
+	if pm.determinant3() == 0 {
+		return dc, false
+	}
+
+	if m[0][3] != 0 || m[1][3] != 0 || m[2][3] != 0 {
+		rhs := [4]float64{m[0][3], m[1][3], m[2][3], m[3][3]}
+
+		inverse, ok := pm.inverse()
+		if !ok {
+			return dc, false
+		}
+
+		// Multiply the vector by the transposed inverse.
+		for col := 0; col < 4; col++ {
+			for row := 0; row < 4; row++ {
+				dc.Perspective[col] += rhs[row] * inverse[col][row]
+			}
+		}
+	} else {
+		dc.Perspective = [4]float64{0, 0, 0, 1}
+	}
+
+	dc.Translate = [3]float64{m[3][0], m[3][1], m[3][2]}
+
+	var rows [3][3]float64
+	for col := 0; col < 3; col++ {
+		rows[col] = [3]float64{m[col][0], m[col][1], m[col][2]}
+	}
+
+	dc.Scale[0] = vecLength(rows[0])
+	rows[0] = vecScale(rows[0], 1/dc.Scale[0])
+
+	dc.Skew[0] = vecDot(rows[0], rows[1])
+	rows[1] = vecCombine(rows[1], rows[0], 1, -dc.Skew[0])
+
+	dc.Scale[1] = vecLength(rows[1])
+	rows[1] = vecScale(rows[1], 1/dc.Scale[1])
+	dc.Skew[0] /= dc.Scale[1]
+
+	dc.Skew[1] = vecDot(rows[0], rows[2])
+	rows[2] = vecCombine(rows[2], rows[0], 1, -dc.Skew[1])
+	dc.Skew[2] = vecDot(rows[1], rows[2])
+	rows[2] = vecCombine(rows[2], rows[1], 1, -dc.Skew[2])
+
+	dc.Scale[2] = vecLength(rows[2])
+	rows[2] = vecScale(rows[2], 1/dc.Scale[2])
+	dc.Skew[1] /= dc.Scale[2]
+	dc.Skew[2] /= dc.Scale[2]
+
+	// Check for a coordinate system flip, negating the scale if found.
+	if vecDot(rows[0], vecCross(rows[1], rows[2])) < 0 {
+		for ind := 0; ind < 3; ind++ {
+			dc.Scale[ind] *= -1
+			rows[ind] = vecScale(rows[ind], -1)
+		}
+	}
+
+	dc.Quaternion = quaternionOf(rows)
+
+	return dc, true
+}
+
+// quaternionOf returns the quaternion of the rotation matrix provided by its
+// columns. Unlike the sign based conversion of the css transforms
+// specification, it also handles rotations of half a turn.
+func quaternionOf(cols [3][3]float64) [4]float64 {
+	r := func(row, col int) float64 {
+		return cols[col][row]
+	}
+
+	trace := r(0, 0) + r(1, 1) + r(2, 2)
+
+	switch {
+	case trace > 0:
+		s := 0.5 / math.Sqrt(trace+1)
+		return [4]float64{(r(2, 1) - r(1, 2)) * s, (r(0, 2) - r(2, 0)) * s, (r(1, 0) - r(0, 1)) * s, 0.25 / s}
+	case r(0, 0) > r(1, 1) && r(0, 0) > r(2, 2):
+		s := 2 * math.Sqrt(1+r(0, 0)-r(1, 1)-r(2, 2))
+		return [4]float64{0.25 * s, (r(0, 1) + r(1, 0)) / s, (r(0, 2) + r(2, 0)) / s, (r(2, 1) - r(1, 2)) / s}
+	case r(1, 1) > r(2, 2):
+		s := 2 * math.Sqrt(1+r(1, 1)-r(0, 0)-r(2, 2))
+		return [4]float64{(r(0, 1) + r(1, 0)) / s, 0.25 * s, (r(1, 2) + r(2, 1)) / s, (r(0, 2) - r(2, 0)) / s}
+	default:
+		s := 2 * math.Sqrt(1+r(2, 2)-r(0, 0)-r(1, 1))
+		return [4]float64{(r(0, 2) + r(2, 0)) / s, (r(1, 2) + r(2, 1)) / s, 0.25 * s, (r(1, 0) - r(0, 1)) / s}
+	}
+}
+
+// Recompose returns the matrix described by the decomposition.
+func (d Decomposition) Recompose() Matrix3D {
+	mx := IdentityMatrix()
+
+	for ind := 0; ind < 4; ind++ {
+		mx[ind][3] = d.Perspective[ind]
+	}
+
+	for ind := 0; ind < 4; ind++ {
+		for axis := 0; axis < 3; axis++ {
+			mx[3][ind] += d.Translate[axis] * mx[axis][ind]
+		}
+	}
+
+	mx = mx.Multiply(d.rotation()).Multiply(d.skew())
+
+	for col := 0; col < 3; col++ {
+		for row := 0; row < 4; row++ {
+			mx[col][row] *= d.Scale[col]
+		}
+	}
+
+	return mx
+}
+
+// skew returns the matrix of the yz, xz and xy skews of the decomposition,
+// applied in that order.
+func (d Decomposition) skew() Matrix3D {
+	mx := IdentityMatrix()
+	temp := IdentityMatrix()
+
+	if d.Skew[2] != 0 {
+		temp[2][1] = d.Skew[2]
+		mx = mx.Multiply(temp)
+	}
+
+	if d.Skew[1] != 0 {
+		temp[2][1] = 0
+		temp[2][0] = d.Skew[1]
+		mx = mx.Multiply(temp)
+	}
+
+	if d.Skew[0] != 0 {
+		temp[2][0] = 0
+		temp[1][0] = d.Skew[0]
+		mx = mx.Multiply(temp)
+	}
+
+	return mx
+}
+
+// rotation returns the rotation matrix of the quaternion of the decomposition.
+func (d Decomposition) rotation() Matrix3D {
+	x, y, z, w := d.Quaternion[0], d.Quaternion[1], d.Quaternion[2], d.Quaternion[3]
+
+	mx := IdentityMatrix()
+	mx[0][0] = 1 - 2*(y*y+z*z)
+	mx[0][1] = 2 * (x*y + z*w)
+	mx[0][2] = 2 * (x*z - y*w)
+	mx[1][0] = 2 * (x*y - z*w)
+	mx[1][1] = 1 - 2*(x*x+z*z)
+	mx[1][2] = 2 * (y*z + x*w)
+	mx[2][0] = 2 * (x*z + y*w)
+	mx[2][1] = 2 * (y*z - x*w)
+	mx[2][2] = 1 - 2*(x*x+y*y)
+
+	return mx
+}
+
+// Rotation returns the rotation of the decomposition as angles in degrees
+// around the x, y and z axes, applied in the order rotateX() rotateY()
+// rotateZ().
+func (d Decomposition) Rotation() (x, y, z float64) {
+	mx := d.rotation()
+
+	sy := math.Max(-1, math.Min(1, mx[2][0]))
+
+	x = math.Atan2(-mx[2][1], mx[2][2])
+	y = math.Asin(sy)
+	z = math.Atan2(-mx[1][0], mx[0][0])
+
+	return x * 180 / math.Pi, y * 180 / math.Pi, z * 180 / math.Pi
+}
+
+// Component returns the value of the decomposition for the transform function
+// of the giving name (eg translateX, rotate, scaleY), in the units used by the
+// transform animators, else returns false if the decomposition does not
+// provide the function.
+func (d Decomposition) Component(name string) (float64, bool) {
+	switch name {
+	case "translateX":
+		return d.Translate[0], true
+	case "translateY":
+		return d.Translate[1], true
+	case "translateZ":
+		return d.Translate[2], true
+	case "scaleX":
+		return d.Scale[0], true
+	case "scaleY":
+		return d.Scale[1], true
+	case "scaleZ":
+		return d.Scale[2], true
+	case "rotateX":
+		x, _, _ := d.Rotation()
+		return x, true
+	case "rotateY":
+		_, y, _ := d.Rotation()
+		return y, true
+	case "rotate", "rotateZ":
+		_, _, z := d.Rotation()
+		return z, true
+	case "skewX":
+		return math.Atan(d.Skew[0]) * 180 / math.Pi, true
+	case "perspective":
+		if d.Perspective[2] == 0 {
+			return 0, true
+		}
+
+		return -1 / d.Perspective[2], true
+	}
+
+	return 0, false
+}
+
+//==============================================================================
+
+// InterpolateMatrix returns the matrix at the progress between the two
+// matrices, by interpolating their decompositions as defined by the css
+// transforms specification. If either matrix can not be decomposed, the from
+// matrix is returned for a progress below 0.5 else the to matrix.
+func InterpolateMatrix(from, to Matrix3D, progress float64) Matrix3D {
+	fd, fok := from.Decompose()
+	td, tok := to.Decompose()
+
+	if !fok || !tok {
+		if progress < 0.5 {
+			return from
+		}
+
+		return to
+	}
+
+	return InterpolateDecomposition(fd, td, progress).Recompose()
+}
+
+// InterpolateDecomposition returns the decomposition at the progress between
+// the two decompositions, where the rotations are spherically interpolated.
+func InterpolateDecomposition(from, to Decomposition, progress float64) Decomposition {
+	var dc Decomposition
+
+	lerp := func(a, b float64) float64 {
+		return a + (b-a)*progress
+	}
+
+	for ind := 0; ind < 3; ind++ {
+		dc.Translate[ind] = lerp(from.Translate[ind], to.Translate[ind])
+		dc.Scale[ind] = lerp(from.Scale[ind], to.Scale[ind])
+		dc.Skew[ind] = lerp(from.Skew[ind], to.Skew[ind])
+	}
+
+	for ind := 0; ind < 4; ind++ {
+		dc.Perspective[ind] = lerp(from.Perspective[ind], to.Perspective[ind])
+	}
+
+	qa, qb := from.Quaternion, to.Quaternion
+
+	product := qa[0]*qb[0] + qa[1]*qb[1] + qa[2]*qb[2] + qa[3]*qb[3]
+	product = math.Max(-1, math.Min(1, product))
+
+	if math.Abs(product) == 1 {
+		dc.Quaternion = qa
+		return dc
+	}
+
+	theta := math.Acos(product)
+	w := math.Sin(progress*theta) / math.Sqrt(1-product*product)
+
+	for ind := 0; ind < 4; ind++ {
+		dc.Quaternion[ind] = qa[ind]*(math.Cos(progress*theta)-product*w) + qb[ind]*w
+	}
+
+	return dc
+}
+
+//==============================================================================
+
+// inverse returns the inverse of the matrix, else returns false if the matrix
+// is singular.
+func (m Matrix3D) inverse() (Matrix3D, bool) {
+	var a [16]float64
+	for col := 0; col < 4; col++ {
+		for row := 0; row < 4; row++ {
+			a[col*4+row] = m[col][row]
+		}
+	}
+
+	var inv [16]float64
+
+	inv[0] = a[5]*a[10]*a[15] - a[5]*a[11]*a[14] - a[9]*a[6]*a[15] + a[9]*a[7]*a[14] + a[13]*a[6]*a[11] - a[13]*a[7]*a[10]
+	inv[4] = -a[4]*a[10]*a[15] + a[4]*a[11]*a[14] + a[8]*a[6]*a[15] - a[8]*a[7]*a[14] - a[12]*a[6]*a[11] + a[12]*a[7]*a[10]
+	inv[8] = a[4]*a[9]*a[15] - a[4]*a[11]*a[13] - a[8]*a[5]*a[15] + a[8]*a[7]*a[13] + a[12]*a[5]*a[11] - a[12]*a[7]*a[9]
+	inv[12] = -a[4]*a[9]*a[14] + a[4]*a[10]*a[13] + a[8]*a[5]*a[14] - a[8]*a[6]*a[13] - a[12]*a[5]*a[10] + a[12]*a[6]*a[9]
+	inv[1] = -a[1]*a[10]*a[15] + a[1]*a[11]*a[14] + a[9]*a[2]*a[15] - a[9]*a[3]*a[14] - a[13]*a[2]*a[11] + a[13]*a[3]*a[10]
+	inv[5] = a[0]*a[10]*a[15] - a[0]*a[11]*a[14] - a[8]*a[2]*a[15] + a[8]*a[3]*a[14] + a[12]*a[2]*a[11] - a[12]*a[3]*a[10]
+	inv[9] = -a[0]*a[9]*a[15] + a[0]*a[11]*a[13] + a[8]*a[1]*a[15] - a[8]*a[3]*a[13] - a[12]*a[1]*a[11] + a[12]*a[3]*a[9]
+	inv[13] = a[0]*a[9]*a[14] - a[0]*a[10]*a[13] - a[8]*a[1]*a[14] + a[8]*a[2]*a[13] + a[12]*a[1]*a[10] - a[12]*a[2]*a[9]
+	inv[2] = a[1]*a[6]*a[15] - a[1]*a[7]*a[14] - a[5]*a[2]*a[15] + a[5]*a[3]*a[14] + a[13]*a[2]*a[7] - a[13]*a[3]*a[6]
+	inv[6] = -a[0]*a[6]*a[15] + a[0]*a[7]*a[14] + a[4]*a[2]*a[15] - a[4]*a[3]*a[14] - a[12]*a[2]*a[7] + a[12]*a[3]*a[6]
+	inv[10] = a[0]*a[5]*a[15] - a[0]*a[7]*a[13] - a[4]*a[1]*a[15] + a[4]*a[3]*a[13] + a[12]*a[1]*a[7] - a[12]*a[3]*a[5]
+	inv[14] = -a[0]*a[5]*a[14] + a[0]*a[6]*a[13] + a[4]*a[1]*a[14] - a[4]*a[2]*a[13] - a[12]*a[1]*a[6] + a[12]*a[2]*a[5]
+	inv[3] = -a[1]*a[6]*a[11] + a[1]*a[7]*a[10] + a[5]*a[2]*a[11] - a[5]*a[3]*a[10] - a[9]*a[2]*a[7] + a[9]*a[3]*a[6]
+	inv[7] = a[0]*a[6]*a[11] - a[0]*a[7]*a[10] - a[4]*a[2]*a[11] + a[4]*a[3]*a[10] + a[8]*a[2]*a[7] - a[8]*a[3]*a[6]
+	inv[11] = -a[0]*a[5]*a[11] + a[0]*a[7]*a[9] + a[4]*a[1]*a[11] - a[4]*a[3]*a[9] - a[8]*a[1]*a[7] + a[8]*a[3]*a[5]
+	inv[15] = a[0]*a[5]*a[10] - a[0]*a[6]*a[9] - a[4]*a[1]*a[10] + a[4]*a[2]*a[9] + a[8]*a[1]*a[6] - a[8]*a[2]*a[5]
+
+	det := a[0]*inv[0] + a[1]*inv[4] + a[2]*inv[8] + a[3]*inv[12]
+	if det == 0 {
+		return m, false
+	}
+
+	var res Matrix3D
+	for ind := 0; ind < 16; ind++ {
+		res[ind/4][ind%4] = inv[ind] / det
+	}
+
+	return res, true
+}
+
+// vecLength returns the length of the vector.
+func vecLength(v [3]float64) float64 {
+	return math.Sqrt(vecDot(v, v))
+}
+
+// vecScale returns the vector scaled by the factor.
+func vecScale(v [3]float64, factor float64) [3]float64 {
+	return [3]float64{v[0] * factor, v[1] * factor, v[2] * factor}
+}
+
+// vecDot returns the dot product of the two vectors.
+func vecDot(a, b [3]float64) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
+
+// vecCross returns the cross product of the two vectors.
+func vecCross(a, b [3]float64) [3]float64 {
+	return [3]float64{
+		a[1]*b[2] - a[2]*b[1],
+		a[2]*b[0] - a[0]*b[2],
+		a[0]*b[1] - a[1]*b[0],
+	}
+}
+
+// vecCombine returns the linear combination of the two vectors.
+func vecCombine(a, b [3]float64, ascale, bscale float64) [3]float64 {
+	return [3]float64{
+		a[0]*ascale + b[0]*bscale,
+		a[1]*ascale + b[1]*bscale,
+		a[2]*ascale + b[2]*bscale,
+	}
+}
+
+//==============================================================================
@@ -0,0 +1,174 @@
+package govfx_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/influx6/govfx"
+)
+
+// near returns true/false if the two values are within a small tolerance.
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-6
+}
+
+// nearMatrix returns true/false if all values of the matrices are within a
+// small tolerance.
+func nearMatrix(a, b govfx.Matrix3D) bool {
+	for col := 0; col < 4; col++ {
+		for row := 0; row < 4; row++ {
+			if !near(a[col][row], b[col][row]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// TestParseMatrix validates the parsing of transform values into matrices.
+func TestParseMatrix(t *testing.T) {
+	mx, err := govfx.ParseMatrix("translate(10px, -20.5px) scale(2)")
+	if err != nil {
+		t.Fatalf("Should have parsed the transform: %s", err)
+	}
+
+	if got := mx.String(); got != "matrix(2, 0, 0, 2, 10, -20.5)" {
+		t.Fatalf("Should have composed the functions in order but got %q", got)
+	}
+
+	mx, err = govfx.ParseMatrix("matrix(0.5, -0.25, 1.5e-1, 1, -3.5, 4)")
+	if err != nil {
+		t.Fatalf("Should have parsed a matrix with negative and decimal values: %s", err)
+	}
+
+	if got := mx.String(); got != "matrix(0.5, -0.25, 0.15, 1, -3.5, 4)" {
+		t.Fatalf("Should have kept the matrix values but got %q", got)
+	}
+
+	mx, err = govfx.ParseMatrix("matrix3d(1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1, -0.002, 5, 6, 7, 1)")
+	if err != nil {
+		t.Fatalf("Should have parsed a matrix3d: %s", err)
+	}
+
+	if mx.Is2D() || mx[3][2] != 7 || mx[2][3] != -0.002 {
+		t.Fatalf("Should have read the matrix3d in column-major order: %s", mx)
+	}
+
+	if _, err := govfx.ParseMatrix("wobble(2)"); err != govfx.ErrInvalidTransform {
+		t.Fatalf("Should have failed for a unknown function: %v", err)
+	}
+
+	rotated, _ := govfx.ParseMatrix("rotate(0.25turn)")
+	degrees, _ := govfx.ParseMatrix("rotate(90deg)")
+	if !nearMatrix(rotated, degrees) {
+		t.Fatalf("Should have converted angle units: %s and %s", rotated, degrees)
+	}
+
+	mt, err := govfx.ToMatrix2D("matrix(1, 0, 0, 1, -10.5, 20)")
+	if err != nil || mt.PositionX != -10.5 || mt.PositionY != 20 {
+		t.Fatalf("Should have read a negative decimal position: %+v %v", mt, err)
+	}
+}
+
+// TestDecomposeMatrix validates the decomposition and recomposition of
+// matrices.
+func TestDecomposeMatrix(t *testing.T) {
+	values := []string{
+		"translate(10px, 20px) rotate(30deg) skewX(20deg) scale(2, 3)",
+		"perspective(400px) translate3d(10px, 5px, -30px) rotateX(40deg) rotateY(-25deg) scale3d(1.5, 0.5, 2)",
+		"scale(-1, 1) rotate(120deg)",
+	}
+
+	for _, value := range values {
+		mx, _ := govfx.ParseMatrix(value)
+
+		dc, ok := mx.Decompose()
+		if !ok {
+			t.Fatalf("Should have decomposed %q", value)
+		}
+
+		// The decomposition is of the matrix normalized by its m[3][3] value.
+		for col := 0; col < 4; col++ {
+			for row := 0; row < 4; row++ {
+				mx[col][row] /= mx[3][3]
+			}
+		}
+
+		if back := dc.Recompose(); !nearMatrix(mx, back) {
+			t.Fatalf("Should have recomposed %q:\n%s\n%s", value, mx, back)
+		}
+	}
+
+	mx, _ := govfx.ParseMatrix("translate(10px, 20px) rotate(30deg) skewX(20deg) scale(2, 3)")
+	dc, _ := mx.Decompose()
+
+	expected := map[string]float64{
+		"translateX": 10,
+		"translateY": 20,
+		"rotate":     30,
+		"skewX":      20,
+		"scaleX":     2,
+		"scaleY":     3,
+	}
+
+	for name, value := range expected {
+		if got, _ := dc.Component(name); !near(got, value) {
+			t.Fatalf("Should have decomposed %s of %.2f but got %.4f", name, value, got)
+		}
+	}
+
+	mx, _ = govfx.ParseMatrix("rotateX(40deg) rotateY(-25deg)")
+	dc, _ = mx.Decompose()
+
+	if x, y, _ := dc.Rotation(); !near(x, 40) || !near(y, -25) {
+		t.Fatalf("Should have decomposed the 3D rotation but got %.4f, %.4f", x, y)
+	}
+
+	if _, ok := (govfx.Matrix3D{}).Decompose(); ok {
+		t.Fatal("Should have failed to decompose a singular matrix")
+	}
+}
+
+// TestInterpolateMatrix validates the interpolation between matrices.
+func TestInterpolateMatrix(t *testing.T) {
+	from, _ := govfx.ParseMatrix("translateX(0px) rotate(0deg) scale(1)")
+	to, _ := govfx.ParseMatrix("translateX(100px) rotate(90deg) scale(3)")
+	half, _ := govfx.ParseMatrix("translateX(50px) rotate(45deg) scale(2)")
+
+	if got := govfx.InterpolateMatrix(from, to, 0.5); !nearMatrix(got, half) {
+		t.Fatalf("Should have interpolated the components:\n%s\n%s", got, half)
+	}
+
+	if got := govfx.InterpolateMatrix(from, to, 1); !nearMatrix(got, to) {
+		t.Fatalf("Should have reached the target matrix:\n%s\n%s", got, to)
+	}
+}
+
+// TestTransformFromMatrix validates that transform animators start from the
+// decomposition of a computed matrix.
+func TestTransformFromMatrix(t *testing.T) {
+	doc := newDocument(1)
+	doc.AddRule(".item", "transform: matrix(0, 2, -2, 0, -40, 10)")
+
+	items := govfx.QuerySelectorAll(".item")
+	timeline, err := govfx.Animate(govfx.Stat{
+		Duration: 1 * time.Second,
+		Clock:    govfx.NewManualClock(time.Unix(0, 0)),
+	}, govfx.Values{
+		{"value": 0, "animate": "translate-x", "easing": "linear"},
+		{"value": 0, "animate": "rotate", "easing": "linear"},
+		{"value": 1, "animate": "scale-x", "easing": "linear"},
+	}, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timeline.Seek(0)
+
+	expected := "transform: translateX(-40px) translateY(10px) rotate(90deg) scaleX(2) scaleY(2)"
+	if style := items[0].GetAttribute("style"); style != expected {
+		t.Fatalf("Should have started from the matrix:\n%q\n%q", style, expected)
+	}
+}
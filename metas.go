@@ -1,6 +1,8 @@
 package govfx
 
 import (
+	"fmt"
+	"reflect"
 	"strings"
 	"sync"
 
@@ -19,8 +21,8 @@ type Values []Value
 type Valueset []Values
 
 // Animator defines a type of function that recieves a constructor to build
-// a sequence from.
-type Animator func(defaults, new Value) Sequence
+// a sequence from, returning an error if the values are invalid for it.
+type Animator func(defaults, new Value) (Sequence, error)
 
 //==============================================================================
 
@@ -88,11 +90,97 @@ const VFXTag = "govfx"
 
 // Merge merges the values within the map with the giving fields of the
 // struct passed in using the govfx tag: "govfx".
-func Merge(instance interface{}, defaults, newVals Value) Sequence {
+// Numeric values are converted to the type of the field they are merged into,
+// hence a int value can be provided for a float64 field, while numeric values
+// merged into a string field are formatted (eg 20 becomes "20") and both
+// numbers and strings are parsed when merged into a Target field (eg "+=20"),
+// where a invalid target returns ErrInvalidTarget.
+func Merge(instance interface{}, defaults, newVals Value) (Sequence, error) {
 	if defaults != nil {
-		reflection.MergeMap(VFXTag, instance, defaults, false)
+		vals, err := convertValues(instance, defaults)
+		if err != nil {
+			return nil, err
+		}
+
+		reflection.MergeMap(VFXTag, instance, vals, false)
+	}
+
+	vals, err := convertValues(instance, newVals)
+	if err != nil {
+		return nil, err
+	}
+
+	reflection.MergeMap(VFXTag, instance, vals, false)
+	return instance.(Sequence), nil
+}
+
+// convertValues returns a copy of the values where every numeric value is
+// converted to the numeric type of the field of the instance it is merged into,
+// or formatted when merged into a string field. Values merged into a Target
+// field are parsed into a Target, returning ErrInvalidTarget if one is
+// invalid.
+func convertValues(instance interface{}, vals Value) (Value, error) {
+	fields, err := reflection.GetTagFields(instance, VFXTag, false)
+	if err != nil {
+		return vals, nil
+	}
+
+	converted := make(Value, len(vals))
+
+	for key, val := range vals {
+		converted[key] = val
+	}
+
+	for _, field := range fields {
+		val, ok := vals[field.Tag]
+		if !ok || val == nil {
+			continue
+		}
+
+		if field.Type == targetType {
+			target, err := ParseTarget(val)
+			if err != nil {
+				return nil, err
+			}
+
+			converted[field.Tag] = target
+			continue
+		}
+
+		rv := reflect.ValueOf(val)
+		if !isNumeric(rv.Kind()) {
+			continue
+		}
+
+		if field.Type.Kind() == reflect.String {
+			converted[field.Tag] = fmt.Sprint(val)
+			continue
+		}
+
+		if !isNumeric(field.Type.Kind()) {
+			continue
+		}
+
+		converted[field.Tag] = rv.Convert(field.Type).Interface()
+	}
+
+	return converted, nil
+}
+
+// targetType defines the reflected type of the Target struct.
+var targetType = reflect.TypeOf(Target{})
+
+// float64Type defines the reflected type of float64 values.
+var float64Type = reflect.TypeOf(float64(0))
+
+// isNumeric returns true/false if the kind is a integer or float kind.
+func isNumeric(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
 	}
 
-	reflection.MergeMap(VFXTag, instance, newVals, false)
-	return instance.(Sequence)
+	return false
 }
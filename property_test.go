@@ -0,0 +1,66 @@
+package govfx_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influx6/govfx"
+	"github.com/influx6/govfx/animators"
+)
+
+// TestPropertyAnimators validates the generic numeric property animators.
+func TestPropertyAnimators(t *testing.T) {
+	govfx.RegisterSequence("test-line-height", animators.NewProperty("line-height", "em").Clamp(1, 2))
+
+	doc := newDocument(1)
+	doc.AddRule(".item", "opacity: 0.2; margin-left: 10px; line-height: 1")
+
+	items := govfx.QuerySelectorAll(".item")
+	timeline, err := govfx.Animate(govfx.Stat{
+		Duration: 1 * time.Second,
+		Clock:    govfx.NewManualClock(time.Unix(0, 0)),
+	}, govfx.Values{
+		{"value": 2, "animate": "opacity", "easing": "linear"},
+		{"value": -30, "animate": "margin-left", "easing": "linear"},
+		{"value": 5, "animate": "test-line-height", "easing": "linear"},
+		{"value": 10, "animate": "font-size", "easing": "linear", "unit": "pt", "max": 12},
+	}, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timeline.SeekProgress(0.5)
+
+	expected := "opacity: 1; margin-left: -10px; line-height: 2em; font-size: 11pt"
+	if style := items[0].GetAttribute("style"); style != expected {
+		t.Fatalf("Should have interpolated and clamped the properties:\n%q\n%q", style, expected)
+	}
+
+	timeline.SeekProgress(0.25)
+
+	expected = "opacity: 0.65; margin-left: 0px; line-height: 2em; font-size: 11.5pt"
+	if style := items[0].GetAttribute("style"); style != expected {
+		t.Fatalf("Should have interpolated the properties:\n%q\n%q", style, expected)
+	}
+}
+
+// TestPropertyEasingError validates that a invalid easing is reported when
+// initializing a animator instead of falling back to the default easing.
+func TestPropertyEasingError(t *testing.T) {
+	newDocument(1)
+
+	prop := animators.NewProperty("opacity", "")
+	prop.Easing = "cubic-bezier(2, 0, 1)"
+
+	err := prop.Init(govfx.QuerySelector(".item"))
+	if _, ok := err.(*govfx.EasingError); !ok {
+		t.Fatalf("Should have failed to initialize with a invalid easing: %v", err)
+	}
+
+	_, err = govfx.Animate(govfx.Stat{Duration: 1 * time.Second}, govfx.Values{
+		{"value": 1, "animate": "no-such-animator"},
+	}, govfx.QuerySelectorAll(".item"))
+	if err == nil {
+		t.Fatal("Should have failed to animate a unknown animator")
+	}
+}
@@ -0,0 +1,267 @@
+package govfx
+
+import (
+	"sync"
+
+	"github.com/influx6/faux/loop"
+)
+
+//==============================================================================
+
+// schedulers contains the Scheduler of every loop engine with running
+// timelines or queued work, an idle Scheduler is removed from it.
+var schedulers = struct {
+	rl sync.Mutex
+	c  map[loop.GameEngine]*Scheduler
+}{c: make(map[loop.GameEngine]*Scheduler)}
+
+// SchedulerOf returns the Scheduler shared by all timelines running on the
+// loop engine, creating it if it does not exists.
+func SchedulerOf(engine loop.GameEngine) *Scheduler {
+	schedulers.rl.Lock()
+	defer schedulers.rl.Unlock()
+
+	sc, ok := schedulers.c[engine]
+	if !ok {
+		sc = NewScheduler(engine)
+		schedulers.c[engine] = sc
+	}
+
+	return sc
+}
+
+// DefaultScheduler returns the Scheduler of the loop engine set by Init, which
+// runs the timelines without a Clock driving their loop.
+func DefaultScheduler() *Scheduler {
+	return SchedulerOf(engine)
+}
+
+// Measure queues the function to run within the measure phase of the next
+// frame of the DefaultScheduler, allowing app code to read from the DOM with
+// the reads of the running animations.
+func Measure(fn func()) {
+	DefaultScheduler().Measure(fn)
+}
+
+// Mutate queues the function to run within the mutate phase of the next frame
+// of the DefaultScheduler, allowing app code to write to the DOM with the
+// writes of the running animations.
+func Mutate(fn func()) {
+	DefaultScheduler().Mutate(fn)
+}
+
+// mutate queues the write within the mutate phase of the frame being run by
+// the Scheduler of the loop engine, else runs it immediately, as writes made
+// outside of a frame (eg when seeking a timeline) are expected to apply at
+// once.
+func mutate(engine loop.GameEngine, fn func()) {
+	schedulers.rl.Lock()
+	sc := schedulers.c[engine]
+	schedulers.rl.Unlock()
+
+	if sc == nil || !sc.inFrame() {
+		fn()
+		return
+	}
+
+	sc.Mutate(fn)
+}
+
+//==============================================================================
+
+// Scheduler defines a frame scheduler which batches the DOM reads and writes
+// of every timeline running on the same loop engine, similar to fastdom.
+// Each frame runs in phases: first the tickers (the timers of the timelines)
+// update their sequences, reading from the DOM, then the measure queue is
+// flushed, then the mutate queue, where all writes of the sequences are
+// queued. Hence all reads of a frame happen before any of its writes, which
+// avoids layout trashing. The scheduler only registers with its loop engine
+// while it has tickers or queued work.
+type Scheduler struct {
+	ml       sync.Mutex
+	engine   loop.GameEngine
+	looper   loop.Looper
+	framing  bool
+	tickers  []*schedulerTicker
+	measures []func()
+	mutates  []func()
+}
+
+// NewScheduler returns a new Scheduler running on the loop engine.
+func NewScheduler(engine loop.GameEngine) *Scheduler {
+	sc := Scheduler{engine: engine}
+	return &sc
+}
+
+// Tick registers the function to be called at the start of every frame until
+// the returned looper is ended.
+func (s *Scheduler) Tick(fn func()) loop.Looper {
+	tk := schedulerTicker{sc: s, fn: fn}
+
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	s.tickers = append(s.tickers, &tk)
+	s.run()
+
+	return &tk
+}
+
+// Measure queues the function to run within the measure phase of the next
+// frame, or the current frame if its measure phase has not ended.
+func (s *Scheduler) Measure(fn func()) {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	s.measures = append(s.measures, fn)
+	s.run()
+}
+
+// Mutate queues the function to run within the mutate phase of the next
+// frame, or the current frame if its mutate phase has not ended.
+func (s *Scheduler) Mutate(fn func()) {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	s.mutates = append(s.mutates, fn)
+	s.run()
+}
+
+// run registers the scheduler with its loop engine if it is not, becoming
+// the Scheduler of the engine again if it was removed once idle. Expects the
+// scheduler lock to be held by the caller.
+func (s *Scheduler) run() {
+	if s.looper != nil {
+		return
+	}
+
+	s.looper = s.engine.Loop(s.frame, 0)
+
+	schedulers.rl.Lock()
+	defer schedulers.rl.Unlock()
+
+	if _, ok := schedulers.c[s.engine]; !ok {
+		schedulers.c[s.engine] = s
+	}
+}
+
+// idle ends the loop of the scheduler and removes it from the schedulers of
+// the loop engines if it has no tickers or queued work, expecting the
+// scheduler lock to be held by the caller.
+func (s *Scheduler) idle() {
+	if len(s.tickers) != 0 || len(s.measures) != 0 || len(s.mutates) != 0 || s.looper == nil {
+		return
+	}
+
+	s.looper.End()
+	s.looper = nil
+
+	schedulers.rl.Lock()
+	defer schedulers.rl.Unlock()
+
+	if schedulers.c[s.engine] == s {
+		delete(schedulers.c, s.engine)
+	}
+}
+
+// frame runs the phases of a single frame.
+func (s *Scheduler) frame(delta float64) {
+	s.ml.Lock()
+	s.framing = true
+	tickers := append([]*schedulerTicker(nil), s.tickers...)
+	s.ml.Unlock()
+
+	for _, tk := range tickers {
+		if !tk.ended() {
+			tk.fn()
+		}
+	}
+
+	s.flush(&s.measures)
+	s.flush(&s.mutates)
+
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	s.framing = false
+	s.idle()
+}
+
+// flush runs the functions of the queue until it is empty, including those
+// queued while flushing.
+func (s *Scheduler) flush(queue *[]func()) {
+	for {
+		s.ml.Lock()
+		fns := *queue
+		*queue = nil
+		s.ml.Unlock()
+
+		if len(fns) == 0 {
+			return
+		}
+
+		for _, fn := range fns {
+			fn()
+		}
+	}
+}
+
+// remove removes the ticker from the scheduler.
+func (s *Scheduler) remove(tk *schedulerTicker) {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	for ind, item := range s.tickers {
+		if item != tk {
+			continue
+		}
+
+		s.tickers = append(s.tickers[:ind], s.tickers[ind+1:]...)
+		break
+	}
+
+	// Outside of a frame, nothing else ends the loop of an idle scheduler.
+	if !s.framing {
+		s.idle()
+	}
+}
+
+// inFrame returns true/false if the scheduler is running a frame.
+func (s *Scheduler) inFrame() bool {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+	return s.framing
+}
+
+//==============================================================================
+
+// schedulerTicker defines a function called on every frame of a Scheduler,
+// implements the loop.Looper interface.
+type schedulerTicker struct {
+	rl   sync.RWMutex
+	sc   *Scheduler
+	fn   func()
+	done bool
+}
+
+// End removes the ticker from its scheduler.
+func (t *schedulerTicker) End(f ...func()) {
+	t.rl.Lock()
+	t.done = true
+	t.rl.Unlock()
+
+	t.sc.remove(t)
+
+	for _, fx := range f {
+		fx()
+	}
+}
+
+// ended returns true/false if the ticker has ended.
+func (t *schedulerTicker) ended() bool {
+	t.rl.RLock()
+	defer t.rl.RUnlock()
+	return t.done
+}
+
+//==============================================================================
@@ -0,0 +1,163 @@
+package govfx_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influx6/govfx"
+)
+
+// phaseBackend defines a headless backend which logs its reads and writes.
+type phaseBackend struct {
+	*govfx.Headless
+	log []string
+}
+
+func (p *phaseBackend) ComputedProperty(elem govfx.DOMElement, pseudo string, prop string) (*govfx.ComputedStyle, error) {
+	p.log = append(p.log, "read")
+	return p.Headless.ComputedProperty(elem, pseudo, prop)
+}
+
+func (p *phaseBackend) SetStyleProperty(elem govfx.DOMElement, prop string, value string, important bool) {
+	p.log = append(p.log, "write")
+	p.Headless.SetStyleProperty(elem, prop, value, important)
+}
+
+// TestScheduler validates that the measure phase, with the reads of app code,
+// runs before the writes of all timelines sharing a loop engine within every
+// frame.
+func TestScheduler(t *testing.T) {
+	doc := newDocument(2)
+	doc.Body().SetAttribute("style", "width: 400px")
+
+	backend := &phaseBackend{Headless: doc}
+	govfx.UseBackend(backend)
+	defer govfx.UseBackend(doc)
+
+	clock := govfx.NewManualClock(time.Unix(0, 0))
+	items := govfx.QuerySelectorAll(".item")
+
+	var timelines []*govfx.Timeline
+
+	for _, item := range items {
+		timeline, err := govfx.Animate(govfx.Stat{
+			Duration: 500 * time.Millisecond,
+			Reverse:  true,
+			Clock:    clock,
+		}, govfx.Values{
+			{"value": "50%", "animate": "width", "easing": "linear"},
+		}, govfx.Elementals{item})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		timelines = append(timelines, timeline)
+	}
+
+	for _, tl := range timelines {
+		tl.Start()
+	}
+
+	scheduler := govfx.SchedulerOf(clock)
+
+	var frames int
+
+	for i := 0; i < 120 && clock.Loops() > 0; i++ {
+		backend.log = nil
+
+		var phases []string
+
+		scheduler.Mutate(func() { phases = append(phases, "mutate") })
+		scheduler.Measure(func() {
+			phases = append(phases, "measure")
+			backend.ComputedProperty(items[0], "", "width")
+		})
+
+		clock.Step(time.Second / 60)
+
+		if strings.Join(phases, " ") != "measure mutate" {
+			t.Fatalf("Should have run the measure phase before the mutate phase but got %v", phases)
+		}
+
+		frame := strings.Join(backend.log, " ")
+		if strings.Contains(frame, "write read") {
+			t.Fatalf("Should have made all reads before any write within frame %d:\n%s", i, frame)
+		}
+
+		if strings.Contains(frame, "read") && strings.Contains(frame, "write") {
+			frames++
+		}
+	}
+
+	if clock.Loops() != 0 {
+		t.Fatalf("Should have released the loop once idle but got %d loops", clock.Loops())
+	}
+
+	if frames == 0 {
+		t.Fatal("Should have had frames with both reads and writes")
+	}
+
+}
+
+// TestSchedulerEngines validates that the schedulers of different loop
+// engines keep their frames apart and are released once idle.
+func TestSchedulerEngines(t *testing.T) {
+	newDocument(2)
+
+	first := govfx.NewManualClock(time.Unix(0, 0))
+	second := govfx.NewManualClock(time.Unix(0, 0))
+	items := govfx.QuerySelectorAll(".item")
+
+	running, err := govfx.Animate(govfx.Stat{
+		Duration: 500 * time.Millisecond,
+		Clock:    first,
+	}, govfx.Values{
+		{"value": 500, "animate": "width", "easing": "linear"},
+	}, govfx.Elementals{items[0]})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seeked, err := govfx.Animate(govfx.Stat{
+		Duration: 500 * time.Millisecond,
+		Clock:    second,
+	}, govfx.Values{
+		{"value": 300, "animate": "width", "easing": "linear"},
+	}, govfx.Elementals{items[1]})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	running.Start()
+
+	scheduler := govfx.SchedulerOf(first)
+	if govfx.SchedulerOf(second) == scheduler {
+		t.Fatal("Should have given each loop engine its own scheduler")
+	}
+
+	var style string
+
+	scheduler.Measure(func() {
+		seeked.SeekProgress(1)
+		style = items[1].GetAttribute("style")
+	})
+
+	first.Step(time.Second / 60)
+
+	if style != "width: 300px" {
+		t.Fatalf("Should have written the seek outside of the frame of another engine at once but got %q", style)
+	}
+
+	for i := 0; i < 60 && first.Loops() > 0; i++ {
+		first.Step(time.Second / 60)
+	}
+
+	if first.Loops() != 0 {
+		t.Fatalf("Should have released the loop once idle but got %d loops", first.Loops())
+	}
+
+	if govfx.SchedulerOf(first) == scheduler {
+		t.Fatal("Should have removed the idle scheduler of the loop engine")
+	}
+}
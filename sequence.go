@@ -19,22 +19,43 @@ type Resetable interface {
 	Reset()
 }
 
-// Blending defines a type with a Blend() function which allows its values to be
-// augmented with a blending factor which allows a interpolation of its values.
+// Blending defines a type with a Blend() function which interpolates its values
+// between its previous and current fixed updates by the provided factor
+// between 0 and 1, the fraction of a fixed step remaining when rendering.
 type Blending interface {
 	Blend(float64)
 }
 
+// Seekable defines a type with a Seek() function which sets its state for the
+// provided progress between 0 and 1, without keeping its previous state for
+// blending. Sequences which are not Seekable are seeked with Update().
+type Seekable interface {
+	Seek(timeline float64)
+}
+
+// seekSequence sets the sequence to its state for the timeline progress.
+func seekSequence(seq Sequence, delta float64, timeline float64) {
+	if sk, ok := seq.(Seekable); ok {
+		sk.Seek(timeline)
+		return
+	}
+
+	seq.Update(delta, timeline)
+}
+
 //==============================================================================
 
 // Sequence defines a series of animation step which will be runned
 // through by calling its .Next() method continousely until the
 // sequence is done(if its not a repetitive sequence).
 // Sequence when calling their next method, all sequences must return a
-// DeferWriter.
+// DeferWriter. Sequences which also implement Declarer emit their state as
+// structured declarations, else their css output is parsed into them. Init
+// returns an error when the sequence can not be initialized for the element
+// (eg a EasingError for a invalid easing).
 type Sequence interface {
 	CSSElem
-	Init(Elemental)
+	Init(Elemental) error
 	Update(delta float64, timeline float64)
 }
 
@@ -48,34 +69,49 @@ type SequenceList []Sequence
 const AnimateAttributeName = "animate"
 
 // GenerateSequence takes a map of animation properties and builds a sequence list
-// from this map.
-func GenerateSequence(vals Values) []Sequence {
+// from this map, returning the error of the first sequence which could not be
+// created.
+func GenerateSequence(vals Values) ([]Sequence, error) {
 	var seqs []Sequence
 
 	for _, prop := range vals {
-		seq, err := NewSequence(prop[AnimateAttributeName].(string), prop)
+		name, _ := prop[AnimateAttributeName].(string)
+
+		seq, err := NewSequence(name, prop)
 		if err != nil {
-			panic(err)
+			return nil, err
 		}
 
 		seqs = append(seqs, seq)
 	}
 
-	return seqs
+	return seqs, nil
 }
 
 //==============================================================================
 
 // NewSequence returns a new sequence tagged by the giving name, using the
 // values map to initialize the attributes accordingly, else returns an
-// error if the sequence name does not exists.
+// error if the sequence name does not exists or its easing or target is
+// invalid. If the values map contains a list of keyframes, a Keyframes
+// sequence is returned.
 func NewSequence(name string, m Value) (Sequence, error) {
 	ani, defaults := animationProviders.Get(name)
 	if ani == nil {
 		return nil, fmt.Errorf("No Sequence with Name[%s]", name)
 	}
 
-	return ani(defaults, m), nil
+	if easing, ok := m["easing"].(string); ok && easing != "" {
+		if _, err := ParseEasing(easing); err != nil {
+			return nil, err
+		}
+	}
+
+	if frames, ok := m[KeyframesAttributeName]; ok {
+		return NewKeyframes(name, m, frames)
+	}
+
+	return ani(defaults, m)
 }
 
 // RegisterSequence adds a sequence by taking a sample value type of the real struct
@@ -87,7 +123,7 @@ func RegisterSequence(name string, structType interface{}) error {
 
 	d, _ := reflection.ToMap(VFXTag, structType, false)
 
-	animationProviders.Add(name, func(d, m Value) Sequence {
+	animationProviders.Add(name, func(d, m Value) (Sequence, error) {
 		newSeq, _ := reflection.MakeNew(structType)
 		return Merge(newSeq, d, m)
 	}, d)
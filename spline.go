@@ -1,5 +1,7 @@
 package govfx
 
+import "math"
+
 //==============================================================================
 
 // PropertyCurves provides a interface for easing values using curves data
@@ -52,27 +54,10 @@ func (s *Spline) X(t float64) float64 {
 // GetTimeForX returns the giving time value between 0 and 1 for the provided
 // x coordinate for a bezier curve.
 func (s *Spline) GetTimeForX(aX float64) float64 {
-	// Newton raphson iteration
-	aGuessT := aX
-
-	for i := 0; i < 4; i++ {
-
-		currentSlope := GetSlope(aGuessT, s.x1, s.y1)
-
-		if currentSlope == 0.0 {
-			return aGuessT
-		}
-
-		currentX := CalculateBezier(aGuessT, s.x1, s.x2) - aX
-
-		aGuessT -= currentX / currentSlope
-
-	}
-
-	return aGuessT
+	return solveBezier(aX, s.x1, s.x2)
 }
 
-// Y returns the provided x value for a giving time between 0 and 1.
+// Y returns the x value of the curve for the provided y value between 0 and 1.
 func (s *Spline) Y(t float64) float64 {
 	if s.optimize {
 		return t
@@ -83,27 +68,50 @@ func (s *Spline) Y(t float64) float64 {
 		return t
 	}
 
-	return CalculateBezier(s.GetTimeForX(t), s.x1, s.x2)
+	return CalculateBezier(s.GetTimeForY(t), s.x1, s.x2)
 }
 
 // GetTimeForY returns the giving time value between 0 and 1 for the provided
 // y coordinate for a bezier curve.
 func (s *Spline) GetTimeForY(aY float64) float64 {
-	// Newton raphson iteration
-	aGuessT := aY
-
-	for i := 0; i < 4; i++ {
+	return solveBezier(aY, s.y1, s.y2)
+}
 
-		currentSlope := GetSlope(aGuessT, s.y1, s.y2)
+// solveBezier returns the time value between 0 and 1 at which the curve of the
+// giving control points reaches the provided value, using newton raphson
+// iterations and falling back to bisection where the curve is too flat for
+// them to converge.
+func solveBezier(aV, aA1, aA2 float64) float64 {
+	aGuessT := aV
+
+	for i := 0; i < 8; i++ {
+		currentSlope := GetSlope(aGuessT, aA1, aA2)
+		if math.Abs(currentSlope) < 1e-3 {
+			break
+		}
 
-		if currentSlope == 0.0 {
+		currentV := CalculateBezier(aGuessT, aA1, aA2) - aV
+		if math.Abs(currentV) < 1e-7 {
 			return aGuessT
 		}
 
-		currentX := CalculateBezier(aGuessT, s.y1, s.y1) - aY
+		aGuessT -= currentV / currentSlope
+	}
+
+	if aGuessT >= 0 && aGuessT <= 1 && math.Abs(CalculateBezier(aGuessT, aA1, aA2)-aV) < 1e-7 {
+		return aGuessT
+	}
 
-		aGuessT -= currentX / currentSlope
+	low, high := 0.0, 1.0
 
+	for i := 0; i < 64; i++ {
+		aGuessT = (low + high) / 2
+
+		if CalculateBezier(aGuessT, aA1, aA2) < aV {
+			low = aGuessT
+		} else {
+			high = aGuessT
+		}
 	}
 
 	return aGuessT
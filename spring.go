@@ -0,0 +1,228 @@
+package govfx
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//==============================================================================
+
+// ErrInvalidSpring defines the error returned when a spring value can not be
+// parsed.
+var ErrInvalidSpring = errors.New("Invalid Spring")
+
+// SpringThreshold defines the default distance from its target, as a fraction
+// of the full distance, within which a spring is considered settled.
+const SpringThreshold = 0.001
+
+// maxSpringDuration defines the longest duration a spring is simulated for
+// when finding the moment it settles.
+const maxSpringDuration = 60 * time.Second
+
+//==============================================================================
+
+// Spring provides a damped spring model moving from 0 towards 1, which is
+// used as an Easing over a fixed duration or with its own settling duration
+// for physics based animations. The velocity is the initial velocity towards
+// the target in distances per second.
+type Spring struct {
+	Mass      float64
+	Stiffness float64
+	Damping   float64
+	Velocity  float64
+	Threshold float64
+
+	ml      sync.Mutex
+	settle  time.Duration
+	settled [5]float64 // settled holds the parameters settle was found for.
+}
+
+// NewSpring returns a new spring with the provided mass, stiffness, damping
+// and initial velocity, settled within the default SpringThreshold.
+func NewSpring(mass, stiffness, damping, velocity float64) *Spring {
+	sp := Spring{
+		Mass:      mass,
+		Stiffness: stiffness,
+		Damping:   damping,
+		Velocity:  velocity,
+		Threshold: SpringThreshold,
+	}
+
+	return &sp
+}
+
+// ParseSpring parses a spring easing in the format spring(mass, stiffness,
+// damping, velocity), where the velocity can be left out.
+func ParseSpring(value string) (*Spring, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+
+	if !strings.HasPrefix(value, "spring(") || !strings.HasSuffix(value, ")") {
+		return nil, ErrInvalidSpring
+	}
+
+	args := strings.Split(value[len("spring("):len(value)-1], ",")
+	if len(args) != 3 && len(args) != 4 {
+		return nil, ErrInvalidSpring
+	}
+
+	var vals [4]float64
+
+	for ind, arg := range args {
+		num, err := strconv.ParseFloat(strings.TrimSpace(arg), 64)
+		if err != nil {
+			return nil, ErrInvalidSpring
+		}
+
+		vals[ind] = num
+	}
+
+	if vals[0] <= 0 || vals[1] <= 0 || vals[2] < 0 {
+		return nil, ErrInvalidSpring
+	}
+
+	return NewSpring(vals[0], vals[1], vals[2], vals[3]), nil
+}
+
+// Ease implements the Easing interface, mapping the progress between 0 and 1
+// onto the settling duration of the spring, hence the spring settles on its
+// target by the end of any fixed duration.
+func (s *Spring) Ease(progress float64) float64 {
+	if progress >= 1 {
+		return 1
+	}
+
+	if progress <= 0 {
+		return 0
+	}
+
+	return s.Position(progress * s.Duration().Seconds())
+}
+
+// Duration returns the time the spring takes to settle within its threshold,
+// which is found again whenever the parameters of the spring changed.
+func (s *Spring) Duration() time.Duration {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	params := [5]float64{s.Mass, s.Stiffness, s.Damping, s.Velocity, s.Threshold}
+	if params != s.settled {
+		s.settle = s.findSettle()
+		s.settled = params
+	}
+
+	return s.settle
+}
+
+// Position returns the position of the spring at the provided time in
+// seconds.
+func (s *Spring) Position(t float64) float64 {
+	x, _ := s.state(t)
+	return 1 + x
+}
+
+// state returns the displacement from the target and the velocity of the
+// spring at the provided time in seconds, solving the damped oscillator for
+// its under, critically and over damped cases.
+func (s *Spring) state(t float64) (float64, float64) {
+	w0 := math.Sqrt(s.Stiffness / s.Mass)
+	zeta := s.Damping / (2 * math.Sqrt(s.Stiffness*s.Mass))
+
+	// The spring starts a full distance away from its target.
+	a := -1.0
+	v0 := s.Velocity
+
+	switch {
+	case zeta < 1:
+		wd := w0 * math.Sqrt(1-zeta*zeta)
+		b := (v0 + zeta*w0*a) / wd
+
+		decay := math.Exp(-zeta * w0 * t)
+		cos, sin := math.Cos(wd*t), math.Sin(wd*t)
+
+		x := decay * (a*cos + b*sin)
+		v := decay * (-zeta*w0*(a*cos+b*sin) + wd*(b*cos-a*sin))
+		return x, v
+
+	case zeta == 1:
+		b := v0 + w0*a
+		decay := math.Exp(-w0 * t)
+
+		x := (a + b*t) * decay
+		v := (b - w0*(a+b*t)) * decay
+		return x, v
+	}
+
+	root := math.Sqrt(zeta*zeta - 1)
+	r1 := -w0 * (zeta - root)
+	r2 := -w0 * (zeta + root)
+
+	c2 := (v0 - r1*a) / (r2 - r1)
+	c1 := a - c2
+
+	x := c1*math.Exp(r1*t) + c2*math.Exp(r2*t)
+	v := r1*c1*math.Exp(r1*t) + r2*c2*math.Exp(r2*t)
+	return x, v
+}
+
+// findSettle returns the first moment in milliseconds the energy of the spring
+// keeps it within its threshold. As the energy of a damped spring never
+// grows, the spring stays settled from that moment.
+func (s *Spring) findSettle() time.Duration {
+	limit := s.Threshold * s.Threshold
+
+	for ms := time.Duration(0); ms < maxSpringDuration; ms += time.Millisecond {
+		x, v := s.state(ms.Seconds())
+
+		if x*x+(s.Mass/s.Stiffness)*v*v <= limit {
+			return ms
+		}
+	}
+
+	return maxSpringDuration
+}
+
+//==============================================================================
+
+// SpringDuration returns the longest settling duration of the springs used as
+// the easing of the values or of their keyframes, else 0 if none use a spring.
+func SpringDuration(vals Values) time.Duration {
+	var duration time.Duration
+
+	for _, val := range vals {
+		if sp, ok := easingOf(val).(*Spring); ok && sp.Duration() > duration {
+			duration = sp.Duration()
+		}
+
+		frames, _ := toValues(val[KeyframesAttributeName])
+
+		if frame := SpringDuration(frames); frame > duration {
+			duration = frame
+		}
+	}
+
+	return duration
+}
+
+// easingOf returns the easing provided by the value, else nil.
+func easingOf(val Value) Easing {
+	var easing Easing
+
+	switch es := val["easing"].(type) {
+	case string:
+		easing = GetEasing(es)
+	case Easing:
+		easing = es
+	}
+
+	if es, ok := val["easer"].(Easing); ok {
+		easing = es
+	}
+
+	return easing
+}
+
+//==============================================================================
@@ -0,0 +1,128 @@
+package govfx_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/influx6/govfx"
+)
+
+// TestSpring validates the behaviour of the spring model across its damping
+// cases.
+func TestSpring(t *testing.T) {
+	expected := []struct {
+		spring    string
+		overshoot bool
+	}{
+		{"spring(1,100,10,0)", true},
+		{"spring(1, 100, 20)", false},
+		{"spring(1,100,40,0)", false},
+		{"spring(2,150,5,10)", true},
+	}
+
+	for _, item := range expected {
+		sp, err := govfx.ParseSpring(item.spring)
+		if err != nil {
+			t.Fatalf("Should have parsed %q: %s", item.spring, err)
+		}
+
+		if !near(sp.Position(0), 0) || sp.Ease(0) != 0 || sp.Ease(1) != 1 {
+			t.Fatalf("Should have moved %q from 0 to 1", item.spring)
+		}
+
+		settle := sp.Duration().Seconds()
+		if settle <= 0 || math.Abs(sp.Position(settle)-1) > govfx.SpringThreshold {
+			t.Fatalf("Should have settled %q within its threshold at %.3fs", item.spring, settle)
+		}
+
+		var max float64
+
+		for at := 0.0; at < settle; at += 0.005 {
+			max = math.Max(max, sp.Position(at))
+		}
+
+		if overshoot := max > 1+govfx.SpringThreshold; overshoot != item.overshoot {
+			t.Fatalf("Should have an overshoot of %t for %q with a peak of %.4f", item.overshoot, item.spring, max)
+		}
+	}
+
+	for _, value := range []string{"spring(1,100)", "spring(0,100,10,0)", "spring(1,a,10,0)", "bounce(1,100,10,0)"} {
+		if _, err := govfx.ParseSpring(value); err != govfx.ErrInvalidSpring {
+			t.Fatalf("Should have failed to parse %q", value)
+		}
+	}
+
+	if _, ok := govfx.GetEasing("spring(1,100,10,0)").(*govfx.Spring); !ok {
+		t.Fatal("Should have registered the spring easing by its name")
+	}
+
+	sp := govfx.NewSpring(1, 100, 10, 0)
+	settle := sp.Duration()
+
+	sp.Threshold = 0.05
+	if loose := sp.Duration(); loose >= settle {
+		t.Fatalf("Should have settled sooner with a larger threshold: %s and %s", loose, settle)
+	}
+}
+
+// TestSpringPhysics validates that animations without a duration run until
+// their springs settle.
+func TestSpringPhysics(t *testing.T) {
+	newDocument(1)
+
+	var ended bool
+
+	clock := govfx.NewManualClock(time.Unix(0, 0))
+	items := govfx.QuerySelectorAll(".item")
+
+	soft, _ := govfx.ParseSpring("spring(1,100,10,0)")
+	stiff, _ := govfx.ParseSpring("spring(1,300,40,0)")
+
+	timeline, err := govfx.Animate(govfx.Stat{
+		Clock: clock,
+		End:   govfx.NewListener(func(float64) { ended = true }),
+	}, govfx.Values{
+		{"value": 100, "animate": "left", "easing": "spring(1,100,10,0)"},
+		{"value": 2, "animate": "opacity", "easer": stiff},
+	}, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if length := timeline.Length(); length != soft.Duration() {
+		t.Fatalf("Should have ran for the settling duration of %s but got %s", soft.Duration(), length)
+	}
+
+	timeline.Start()
+
+	var elapsed time.Duration
+
+	for !ended && elapsed < 5*time.Second {
+		clock.Step(time.Second / 60)
+		elapsed += time.Second / 60
+	}
+
+	if !ended || elapsed < soft.Duration() || elapsed > soft.Duration()+time.Second/30 {
+		t.Fatalf("Should have ended once the spring settled at %s but ran for %s", soft.Duration(), elapsed)
+	}
+
+	expected := "left: 100px; opacity: 1"
+	if style := items[0].GetAttribute("style"); style != expected {
+		t.Fatalf("Should have settled on the targets:\n%q\n%q", style, expected)
+	}
+
+	keyframed := govfx.SpringDuration(govfx.Values{
+		{
+			"animate": "left",
+			"keyframes": []interface{}{
+				map[string]interface{}{"at": 0, "value": 0, "easer": stiff},
+				map[string]interface{}{"at": 1, "value": 100},
+			},
+		},
+	})
+
+	if keyframed != stiff.Duration() {
+		t.Fatalf("Should have used the spring of the keyframes for %s but got %s", stiff.Duration(), keyframed)
+	}
+}
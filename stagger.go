@@ -0,0 +1,84 @@
+package govfx
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+//==============================================================================
+
+// StaggerOrder defines the order in which the elements of a stagger start.
+type StaggerOrder int
+
+// contains the different orders for a stagger.
+const (
+	StaggerFromStart StaggerOrder = iota
+	StaggerFromEnd
+	StaggerFromCenter
+	StaggerRandom
+)
+
+// StaggerFunc defines a function which returns the delay for the element at
+// the giving index.
+type StaggerFunc func(index int, elem Elemental) time.Duration
+
+// Stagger provides a configuration for delaying the start of each element of
+// a animation, while all the elements still share a single timeline. Each
+// element is delayed by Each multiplied by its position in the order set by
+// From, where a Func when provided is used instead to compute the delay of
+// every element. The Seed is used to shuffle the elements for StaggerRandom.
+type Stagger struct {
+	Each time.Duration
+	From StaggerOrder
+	Seed int64
+	Func StaggerFunc
+}
+
+// Offsets returns the delay of every element in the provided list.
+func (s Stagger) Offsets(elems Elementals) []time.Duration {
+	offsets := make([]time.Duration, len(elems))
+
+	if s.Func != nil {
+		for ind, elem := range elems {
+			if offsets[ind] = s.Func(ind, elem); offsets[ind] < 0 {
+				offsets[ind] = 0
+			}
+		}
+
+		return offsets
+	}
+
+	if s.Each <= 0 {
+		return offsets
+	}
+
+	total := len(elems)
+
+	var order []int
+
+	if s.From == StaggerRandom {
+		order = rand.New(rand.NewSource(s.Seed)).Perm(total)
+	}
+
+	for ind := range elems {
+		var position float64
+
+		switch s.From {
+		case StaggerFromEnd:
+			position = float64(total - 1 - ind)
+		case StaggerFromCenter:
+			position = math.Abs(float64(ind) - float64(total-1)/2)
+		case StaggerRandom:
+			position = float64(order[ind])
+		default:
+			position = float64(ind)
+		}
+
+		offsets[ind] = time.Duration(position * float64(s.Each))
+	}
+
+	return offsets
+}
+
+//==============================================================================
@@ -0,0 +1,100 @@
+package govfx_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influx6/govfx"
+)
+
+// staggered returns the timeline of a offset animation over three elements
+// using the provided stagger.
+func staggered(t *testing.T, stagger govfx.Stagger) (*govfx.Timeline, govfx.Elementals) {
+	newDocument(3)
+
+	items := govfx.QuerySelectorAll(".item")
+	timeline, err := govfx.Animate(govfx.Stat{
+		Duration: 1 * time.Second,
+		Stagger:  stagger,
+		Clock:    govfx.NewManualClock(time.Unix(0, 0)),
+	}, govfx.Values{
+		{"value": 100, "animate": "test-offset", "easing": "linear"},
+	}, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return timeline, items
+}
+
+// lefts returns the left offsets written into the style of the elements.
+func lefts(items govfx.Elementals) []int {
+	var offsets []int
+
+	for _, item := range items {
+		offsets = append(offsets, govfx.ParseInt(item.GetAttribute("style")))
+	}
+
+	return offsets
+}
+
+// TestStagger validates the delayed start of each element of a staggered
+// animation.
+func TestStagger(t *testing.T) {
+	expected := []struct {
+		stagger govfx.Stagger
+		length  time.Duration
+		lefts   []int
+	}{
+		{govfx.Stagger{Each: 500 * time.Millisecond}, 2 * time.Second, []int{100, 50, 0}},
+		{govfx.Stagger{Each: 500 * time.Millisecond, From: govfx.StaggerFromEnd}, 2 * time.Second, []int{0, 50, 100}},
+		{govfx.Stagger{Each: 500 * time.Millisecond, From: govfx.StaggerFromCenter}, 1500 * time.Millisecond, []int{50, 100, 50}},
+		{govfx.Stagger{Func: func(index int, _ govfx.Elemental) time.Duration {
+			return time.Duration(index*index) * 250 * time.Millisecond
+		}}, 2 * time.Second, []int{100, 75, 0}},
+	}
+
+	for ind, item := range expected {
+		timeline, items := staggered(t, item.stagger)
+
+		if length := timeline.Length(); length != item.length {
+			t.Fatalf("Should have extended stagger %d to %s but got %s", ind, item.length, length)
+		}
+
+		timeline.Seek(1 * time.Second)
+
+		got := lefts(items)
+		for el, left := range item.lefts {
+			if got[el] != left {
+				t.Fatalf("Should have stagger %d element %d at %d but got %v", ind, el, left, got)
+			}
+		}
+
+		timeline.SeekProgress(1)
+
+		for el, left := range lefts(items) {
+			if left != 100 {
+				t.Fatalf("Should have ended stagger %d element %d at 100 but got %d", ind, el, left)
+			}
+		}
+	}
+
+	// A random stagger must be reproducible with the same seed.
+	random := govfx.Stagger{Each: 100 * time.Millisecond, From: govfx.StaggerRandom, Seed: 7}
+	first := random.Offsets(make(govfx.Elementals, 5))
+	second := random.Offsets(make(govfx.Elementals, 5))
+
+	seen := make(map[time.Duration]bool)
+
+	for ind := range first {
+		if first[ind] != second[ind] {
+			t.Fatalf("Should have shuffled identically with the same seed: %v and %v", first, second)
+		}
+
+		seen[first[ind]] = true
+	}
+
+	if len(seen) != 5 {
+		t.Fatalf("Should have given every element a distinct delay: %v", first)
+	}
+}
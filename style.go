@@ -0,0 +1,189 @@
+package govfx
+
+import "sync"
+
+//==============================================================================
+
+// inlineValue defines the inline value of a property before govfx owned it.
+type inlineValue struct {
+	Value     string
+	Important bool
+}
+
+// WriteCount defines the number of writes made to the DOM, where Elements
+// counts the element styles changed and Properties the properties set.
+type WriteCount struct {
+	Elements   int64
+	Properties int64
+}
+
+// Add returns the sum of both counts.
+func (w WriteCount) Add(other WriteCount) WriteCount {
+	return WriteCount{
+		Elements:   w.Elements + other.Elements,
+		Properties: w.Properties + other.Properties,
+	}
+}
+
+//==============================================================================
+
+// StyleWriter defines a writer of the inline style of a element, which sets
+// the properties it writes one by one through the backend (style.setProperty
+// within the browser), leaving the other inline declarations of the element
+// as they are. The writer owns every property it writes and keeps its
+// original inline value, until it restores them.
+// The writer remembers the last value it wrote for every property and skips
+// writing a property again with the same value, hence changes made to owned
+// properties by others are not overwritten until their animated value changes.
+// A property which was set inline as important is always written as important,
+// as the inline style is the only place its priority can be read from within
+// the browser.
+type StyleWriter struct {
+	ml      sync.Mutex
+	elem    DOMElement
+	owned   map[string]inlineValue
+	written map[string]inlineValue
+	order   []string
+	count   WriteCount
+	setting bool // setting is true while properties are written through Set.
+}
+
+// NewStyleWriter returns a new StyleWriter for the element.
+func NewStyleWriter(elem DOMElement) *StyleWriter {
+	sw := StyleWriter{
+		elem:    elem,
+		owned:   make(map[string]inlineValue),
+		written: make(map[string]inlineValue),
+	}
+
+	return &sw
+}
+
+// Set writes the value of the property with the provided priority, taking
+// ownership of the property on its first write. Returns false if the value
+// was already written and was skipped. Properties set one after the other
+// count as a single write of the element, until the next WriteDeclarations or
+// Restore.
+func (s *StyleWriter) Set(prop string, value string, important bool) bool {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	if !s.set(prop, value, important) {
+		return false
+	}
+
+	if !s.setting {
+		s.setting = true
+		s.count.Elements++
+	}
+
+	return true
+}
+
+// set writes the value of the property unless it was the last value written,
+// expecting the writer lock to be held by the caller.
+func (s *StyleWriter) set(prop string, value string, important bool) bool {
+	be := GetBackend()
+
+	original, ok := s.owned[prop]
+	if !ok {
+		val, imp := be.StyleProperty(s.elem, prop)
+		original = inlineValue{Value: val, Important: imp}
+	}
+
+	next := inlineValue{Value: value, Important: important || original.Important}
+	if last, ok := s.written[prop]; ok && last == next {
+		return false
+	}
+
+	if _, ok := s.owned[prop]; !ok {
+		s.owned[prop] = original
+		s.order = append(s.order, prop)
+	}
+
+	be.SetStyleProperty(s.elem, prop, next.Value, next.Important)
+
+	s.written[prop] = next
+	s.count.Properties++
+	return true
+}
+
+// WriteDeclarations writes the declarations of the block whose values changed
+// since they were last written, returning the number of properties written.
+func (s *StyleWriter) WriteDeclarations(decls Declarations) int {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	s.setting = false
+
+	var written int
+
+	for _, decl := range decls {
+		if s.set(decl.Property, decl.CSSValue(), decl.Important) {
+			written++
+		}
+	}
+
+	if written != 0 {
+		s.count.Elements++
+	}
+
+	return written
+}
+
+// Writes returns the number of writes made by the writer.
+func (s *StyleWriter) Writes() WriteCount {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+	return s.count
+}
+
+// Owns returns true/false if the writer owns the property.
+func (s *StyleWriter) Owns(prop string) bool {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	_, ok := s.owned[prop]
+	return ok
+}
+
+// Owned returns the properties owned by the writer in the order they were
+// first written.
+func (s *StyleWriter) Owned() []string {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	return append([]string(nil), s.order...)
+}
+
+// Restore sets every owned property back to its original inline value,
+// removing those which were not set inline, and releases their ownership.
+func (s *StyleWriter) Restore() {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	be := GetBackend()
+	s.setting = false
+
+	if len(s.order) != 0 {
+		s.count.Elements++
+		s.count.Properties += int64(len(s.order))
+	}
+
+	for _, prop := range s.order {
+		original := s.owned[prop]
+
+		if original.Value == "" {
+			be.RemoveStyleProperty(s.elem, prop)
+			continue
+		}
+
+		be.SetStyleProperty(s.elem, prop, original.Value, original.Important)
+	}
+
+	s.owned = make(map[string]inlineValue)
+	s.written = make(map[string]inlineValue)
+	s.order = nil
+}
+
+//==============================================================================
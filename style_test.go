@@ -0,0 +1,150 @@
+package govfx_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influx6/govfx"
+)
+
+// TestStyleWriter validates that animations merge into the inline style of
+// elements and restore it when cancelled.
+func TestStyleWriter(t *testing.T) {
+	doc := newDocument(1)
+	doc.Body().Children()[0].SetAttribute("style", "color: red; width: 40px !important")
+
+	var ended bool
+
+	clock := govfx.NewManualClock(time.Unix(0, 0))
+	items := govfx.QuerySelectorAll(".item")
+
+	timeline, err := govfx.Animate(govfx.Stat{
+		Duration: 1 * time.Second,
+		Clock:    clock,
+		End:      govfx.NewListener(func(float64) { ended = true }),
+	}, govfx.Values{
+		{"value": 80, "animate": "width", "easing": "linear"},
+		{"value": 100, "animate": "height", "easing": "linear"},
+		{"value": 1, "animate": "opacity", "easing": "linear"},
+	}, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timeline.SeekProgress(1)
+
+	expected := "color: red; width: 80px !important; height: 100px; opacity: 1"
+	if style := items[0].GetAttribute("style"); style != expected {
+		t.Fatalf("Should have merged the animated properties:\n%q\n%q", style, expected)
+	}
+
+	owned := items[0].Styles().Owned()
+	if len(owned) != 3 || !items[0].Styles().Owns("width") || items[0].Styles().Owns("color") {
+		t.Fatalf("Should have owned only the animated properties but got %v", owned)
+	}
+
+	timeline.Start()
+
+	for i := 0; i < 20; i++ {
+		clock.Step(time.Second / 60)
+	}
+
+	timeline.Cancel()
+
+	expected = "color: red; width: 40px !important"
+	if style := items[0].GetAttribute("style"); style != expected {
+		t.Fatalf("Should have restored the original inline style:\n%q\n%q", style, expected)
+	}
+
+	for i := 0; i < 120; i++ {
+		clock.Step(time.Second / 60)
+	}
+
+	if style := items[0].GetAttribute("style"); style != expected || ended {
+		t.Fatalf("Should have stopped the cancelled timeline but got %q", style)
+	}
+}
+
+// TestStyleWriterSet validates that properties set one after the other are
+// counted as a single write of the element.
+func TestStyleWriterSet(t *testing.T) {
+	newDocument(1)
+
+	styles := govfx.NewStyleWriter(govfx.QuerySelector(".item"))
+	styles.Set("width", "10px", false)
+	styles.Set("height", "10px", false)
+
+	if count := styles.Writes(); count != (govfx.WriteCount{Elements: 1, Properties: 2}) {
+		t.Fatalf("Should have counted the element once for its properties but got %+v", count)
+	}
+}
+
+// TestFrameDiffing validates that only changed properties are written and
+// that the writes of a timeline are counted.
+func TestFrameDiffing(t *testing.T) {
+	newDocument(2)
+
+	clock := govfx.NewManualClock(time.Unix(0, 0))
+	items := govfx.QuerySelectorAll(".item")
+
+	timeline, err := govfx.Animate(govfx.Stat{
+		Duration: 1 * time.Second,
+		Clock:    clock,
+	}, govfx.Values{
+		{"value": 100, "animate": "width", "easing": "linear"},
+		{"value": 0, "animate": "opacity", "easing": "linear"},
+	}, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []struct {
+		at    float64
+		count govfx.WriteCount
+	}{
+		{0.5, govfx.WriteCount{Elements: 2, Properties: 4}},
+		{0.5, govfx.WriteCount{Elements: 2, Properties: 4}},
+		{1, govfx.WriteCount{Elements: 4, Properties: 6}},
+		{1, govfx.WriteCount{Elements: 4, Properties: 6}},
+	}
+
+	for _, step := range expected {
+		timeline.SeekProgress(step.at)
+
+		if count := timeline.Writes(); count != step.count {
+			t.Fatalf("Should have made %+v writes at %.2f but made %+v", step.count, step.at, count)
+		}
+	}
+
+	if count := items[0].Styles().Writes(); count.Properties != 3 {
+		t.Fatalf("Should have written the settled width once but got %+v", count)
+	}
+
+	timeline.SeekProgress(0)
+	timeline.Start()
+
+	var frames int64
+
+	for i := 0; i < 90; i++ {
+		clock.Step(time.Second / 60)
+		frames++
+	}
+
+	count := timeline.Writes()
+	if count.Elements > 6+2*frames || count.Properties >= count.Elements*2 {
+		t.Fatalf("Should have skipped the unchanged width while running but made %+v writes", count)
+	}
+
+	for i := 0; i < 60; i++ {
+		clock.Step(time.Second / 60)
+	}
+
+	if after := timeline.Writes(); after != count {
+		t.Fatalf("Should have made no writes after settling but went from %+v to %+v", count, after)
+	}
+
+	expectedStyle := "width: 100px; opacity: 0"
+	if style := items[1].GetAttribute("style"); style != expectedStyle {
+		t.Fatalf("Should have settled on the targets:\n%q\n%q", style, expectedStyle)
+	}
+}
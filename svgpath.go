@@ -0,0 +1,417 @@
+package govfx
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//==============================================================================
+
+// ErrInvalidPath defines the error returned when svg path data can not be
+// parsed.
+var ErrInvalidPath = errors.New("Invalid Path")
+
+// pathSubdivisions defines the number of lines each curve of a path is
+// flattened into for measuring its length.
+const pathSubdivisions = 64
+
+// Point defines a position within a svg path.
+type Point struct {
+	X float64
+	Y float64
+}
+
+// distance returns the distance between the points.
+func (p Point) distance(o Point) float64 {
+	return math.Hypot(o.X-p.X, o.Y-p.Y)
+}
+
+//==============================================================================
+
+// Path defines svg path data flattened into lines, parameterized by the
+// length along the path for sampling positions without a browser.
+type Path struct {
+	points  []Point
+	lengths []float64
+}
+
+// ParsePath parses svg path data, supporting the absolute and relative forms
+// of the M, L, H, V, C, S, Q, T, A and Z commands.
+func ParsePath(data string) (*Path, error) {
+	var path Path
+
+	ps := pathScanner{data: data}
+
+	var cmd, last byte
+	var cur, start, ctrl Point
+
+	for {
+		ps.skip()
+		if ps.done() {
+			break
+		}
+
+		if next := ps.data[ps.pos]; strings.IndexByte("MmLlHhVvCcSsQqTtAaZz", next) >= 0 {
+			cmd = next
+			ps.pos++
+		} else if cmd == 0 || cmd == 'Z' || cmd == 'z' {
+			return nil, ErrInvalidPath
+		}
+
+		if len(path.points) == 0 && cmd != 'M' && cmd != 'm' {
+			return nil, ErrInvalidPath
+		}
+
+		var origin Point
+		if cmd >= 'a' {
+			origin = cur
+		}
+
+		upper := cmd &^ 0x20
+
+		switch upper {
+		case 'M':
+			pt, ok := ps.point(origin)
+			if !ok {
+				return nil, ErrInvalidPath
+			}
+
+			path.move(pt)
+			cur, start = pt, pt
+
+			// Coordinates following a moveto are implicit linetos.
+			cmd = 'L' | (cmd & 0x20)
+
+		case 'L':
+			pt, ok := ps.point(origin)
+			if !ok {
+				return nil, ErrInvalidPath
+			}
+
+			path.line(cur, pt)
+			cur = pt
+
+		case 'H', 'V':
+			num, ok := ps.number()
+			if !ok {
+				return nil, ErrInvalidPath
+			}
+
+			pt := cur
+			if upper == 'H' {
+				pt.X = origin.X + num
+			} else {
+				pt.Y = origin.Y + num
+			}
+
+			path.line(cur, pt)
+			cur = pt
+
+		case 'C', 'S':
+			c1 := cur
+			if upper == 'S' && (last == 'C' || last == 'S') {
+				c1 = Point{X: 2*cur.X - ctrl.X, Y: 2*cur.Y - ctrl.Y}
+			}
+
+			var ok bool
+			if upper == 'C' {
+				if c1, ok = ps.point(origin); !ok {
+					return nil, ErrInvalidPath
+				}
+			}
+
+			c2, ok := ps.point(origin)
+			if !ok {
+				return nil, ErrInvalidPath
+			}
+
+			pt, ok := ps.point(origin)
+			if !ok {
+				return nil, ErrInvalidPath
+			}
+
+			path.cubic(cur, c1, c2, pt)
+			cur, ctrl = pt, c2
+
+		case 'Q', 'T':
+			c := cur
+			if upper == 'T' && (last == 'Q' || last == 'T') {
+				c = Point{X: 2*cur.X - ctrl.X, Y: 2*cur.Y - ctrl.Y}
+			}
+
+			var ok bool
+			if upper == 'Q' {
+				if c, ok = ps.point(origin); !ok {
+					return nil, ErrInvalidPath
+				}
+			}
+
+			pt, ok := ps.point(origin)
+			if !ok {
+				return nil, ErrInvalidPath
+			}
+
+			path.quadratic(cur, c, pt)
+			cur, ctrl = pt, c
+
+		case 'A':
+			rx, ok1 := ps.number()
+			ry, ok2 := ps.number()
+			rotation, ok3 := ps.number()
+			large, ok4 := ps.flag()
+			sweep, ok5 := ps.flag()
+			pt, ok6 := ps.point(origin)
+
+			if !ok1 || !ok2 || !ok3 || !ok4 || !ok5 || !ok6 {
+				return nil, ErrInvalidPath
+			}
+
+			path.arc(cur, rx, ry, rotation, large, sweep, pt)
+			cur = pt
+
+		case 'Z':
+			path.line(cur, start)
+			cur = start
+		}
+
+		last = upper
+	}
+
+	if len(path.points) == 0 {
+		return nil, ErrInvalidPath
+	}
+
+	return &path, nil
+}
+
+// TotalLength returns the length of the path.
+func (p *Path) TotalLength() float64 {
+	return p.lengths[len(p.lengths)-1]
+}
+
+// PointAtLength returns the point at the provided length along the path,
+// clamped to the start and end of the path.
+func (p *Path) PointAtLength(length float64) Point {
+	index := sort.SearchFloat64s(p.lengths, length)
+
+	if index == 0 {
+		return p.points[0]
+	}
+
+	if index >= len(p.points) {
+		return p.points[len(p.points)-1]
+	}
+
+	from, to := p.lengths[index-1], p.lengths[index]
+	if to == from {
+		return p.points[index]
+	}
+
+	amount := (length - from) / (to - from)
+
+	return Point{
+		X: Lerp(p.points[index-1].X, p.points[index].X, amount),
+		Y: Lerp(p.points[index-1].Y, p.points[index].Y, amount),
+	}
+}
+
+// move starts a new subpath at the point, which adds no length to the path.
+func (p *Path) move(pt Point) {
+	var length float64
+	if len(p.lengths) != 0 {
+		length = p.TotalLength()
+	}
+
+	p.points = append(p.points, pt)
+	p.lengths = append(p.lengths, length)
+}
+
+// add flattens the segment provided by the function of the progress along it
+// into the number of lines.
+func (p *Path) add(steps int, at func(t float64) Point) {
+	for step := 1; step <= steps; step++ {
+		pt := at(float64(step) / float64(steps))
+
+		p.lengths = append(p.lengths, p.TotalLength()+p.points[len(p.points)-1].distance(pt))
+		p.points = append(p.points, pt)
+	}
+}
+
+// line adds a straight line to the path.
+func (p *Path) line(from, to Point) {
+	p.add(1, func(t float64) Point {
+		return Point{X: Lerp(from.X, to.X, t), Y: Lerp(from.Y, to.Y, t)}
+	})
+}
+
+// cubic adds a cubic bezier curve to the path.
+func (p *Path) cubic(from, c1, c2, to Point) {
+	p.add(pathSubdivisions, func(t float64) Point {
+		mt := 1 - t
+		a, b, c, d := mt*mt*mt, 3*mt*mt*t, 3*mt*t*t, t*t*t
+
+		return Point{
+			X: a*from.X + b*c1.X + c*c2.X + d*to.X,
+			Y: a*from.Y + b*c1.Y + c*c2.Y + d*to.Y,
+		}
+	})
+}
+
+// quadratic adds a quadratic bezier curve to the path.
+func (p *Path) quadratic(from, c, to Point) {
+	p.add(pathSubdivisions, func(t float64) Point {
+		mt := 1 - t
+		a, b, d := mt*mt, 2*mt*t, t*t
+
+		return Point{
+			X: a*from.X + b*c.X + d*to.X,
+			Y: a*from.Y + b*c.Y + d*to.Y,
+		}
+	})
+}
+
+// arc adds a elliptical arc to the path, converting its endpoint parameters
+// to its center as the svg specification describes.
+func (p *Path) arc(from Point, rx, ry, rotation float64, large, sweep bool, to Point) {
+	if from == to {
+		return
+	}
+
+	rx, ry = math.Abs(rx), math.Abs(ry)
+	if rx == 0 || ry == 0 {
+		p.line(from, to)
+		return
+	}
+
+	sin, cos := math.Sincos(rotation * math.Pi / 180)
+
+	dx, dy := (from.X-to.X)/2, (from.Y-to.Y)/2
+	x1, y1 := cos*dx+sin*dy, -sin*dx+cos*dy
+
+	// Scale up radii too small to reach the end point.
+	if lambda := (x1*x1)/(rx*rx) + (y1*y1)/(ry*ry); lambda > 1 {
+		rx, ry = rx*math.Sqrt(lambda), ry*math.Sqrt(lambda)
+	}
+
+	num := rx*rx*ry*ry - rx*rx*y1*y1 - ry*ry*x1*x1
+	den := rx*rx*y1*y1 + ry*ry*x1*x1
+
+	coef := math.Sqrt(math.Max(0, num/den))
+	if large == sweep {
+		coef = -coef
+	}
+
+	cx1, cy1 := coef*rx*y1/ry, -coef*ry*x1/rx
+	cx := cos*cx1 - sin*cy1 + (from.X+to.X)/2
+	cy := sin*cx1 + cos*cy1 + (from.Y+to.Y)/2
+
+	theta := math.Atan2((y1-cy1)/ry, (x1-cx1)/rx)
+	delta := math.Atan2((-y1-cy1)/ry, (-x1-cx1)/rx) - theta
+
+	if sweep && delta < 0 {
+		delta += 2 * math.Pi
+	} else if !sweep && delta > 0 {
+		delta -= 2 * math.Pi
+	}
+
+	p.add(pathSubdivisions, func(t float64) Point {
+		asin, acos := math.Sincos(theta + t*delta)
+
+		return Point{
+			X: cx + rx*acos*cos - ry*asin*sin,
+			Y: cy + rx*acos*sin + ry*asin*cos,
+		}
+	})
+}
+
+//==============================================================================
+
+// pathScanner reads the numbers and flags of svg path data.
+type pathScanner struct {
+	data string
+	pos  int
+}
+
+// done returns true/false if all of the data has been read.
+func (ps *pathScanner) done() bool {
+	return ps.pos >= len(ps.data)
+}
+
+// skip moves past the whitespace and commas separating values.
+func (ps *pathScanner) skip() {
+	for !ps.done() && strings.IndexByte(" \t\r\n,", ps.data[ps.pos]) >= 0 {
+		ps.pos++
+	}
+}
+
+// number reads the next number, which ends at any character which can not
+// continue it, eg "-.5.5" reads as -.5 and .5.
+func (ps *pathScanner) number() (float64, bool) {
+	ps.skip()
+
+	start := ps.pos
+	var dot, exp, digits bool
+
+	for !ps.done() {
+		ch := ps.data[ps.pos]
+
+		switch {
+		case ch >= '0' && ch <= '9':
+			digits = true
+		case (ch == '+' || ch == '-') && (ps.pos == start || ps.data[ps.pos-1] == 'e' || ps.data[ps.pos-1] == 'E'):
+		case ch == '.' && !dot && !exp:
+			dot = true
+		case (ch == 'e' || ch == 'E') && digits && !exp:
+			exp = true
+		default:
+			return ps.parse(start, digits)
+		}
+
+		ps.pos++
+	}
+
+	return ps.parse(start, digits)
+}
+
+// parse returns the number read from the start position.
+func (ps *pathScanner) parse(start int, digits bool) (float64, bool) {
+	if !digits {
+		return 0, false
+	}
+
+	num, err := strconv.ParseFloat(ps.data[start:ps.pos], 64)
+	return num, err == nil
+}
+
+// flag reads the next arc flag, which may not be separated from the values
+// which follow it.
+func (ps *pathScanner) flag() (bool, bool) {
+	ps.skip()
+
+	if ps.done() || (ps.data[ps.pos] != '0' && ps.data[ps.pos] != '1') {
+		return false, false
+	}
+
+	ps.pos++
+	return ps.data[ps.pos-1] == '1', true
+}
+
+// point reads the next coordinate pair relative to the origin.
+func (ps *pathScanner) point(origin Point) (Point, bool) {
+	x, ok := ps.number()
+	if !ok {
+		return Point{}, false
+	}
+
+	y, ok := ps.number()
+	if !ok {
+		return Point{}, false
+	}
+
+	return Point{X: origin.X + x, Y: origin.Y + y}, true
+}
+
+//==============================================================================
@@ -0,0 +1,101 @@
+package govfx_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/influx6/govfx"
+)
+
+// TestParsePath validates the parsing and measuring of svg path data.
+func TestParsePath(t *testing.T) {
+	expected := []struct {
+		path   string
+		length float64
+		half   govfx.Point
+	}{
+		{"M0,0 L100,0", 100, govfx.Point{X: 50}},
+		{"M0 0 H30 V40 Z", 120, govfx.Point{X: 30, Y: 30}},
+		{"m10 10 l10 0 l0 10 h-10 z", 40, govfx.Point{X: 20, Y: 20}},
+		{"M0,0 10,0 10,10", 20, govfx.Point{X: 10}},
+		{"M0-10-.5.5", 10.5, govfx.Point{X: -0.25, Y: -4.75}},
+		{"M0,0 C0,0 100,0 100,0", 100, govfx.Point{X: 50}},
+		{"M0,0 Q50,0 100,0 T200,0", 200, govfx.Point{X: 100}},
+		{"M0,0 A50,50 0 0,1 100,0", 50 * math.Pi, govfx.Point{X: 50, Y: -50}},
+		{"M0,0 a50 50 0 1 0 100 0", 50 * math.Pi, govfx.Point{X: 50, Y: 50}},
+		{"M0,0 A1,1 0 0,1 100,0", 50 * math.Pi, govfx.Point{X: 50, Y: -50}},
+		{"M0,0 A50,50 0 1,1 0,0 M0,0 L10,0", 10, govfx.Point{X: 5}},
+		{"M0,0 c0,0 10,0 10,0 s10,0 10,0", 20, govfx.Point{X: 10}},
+	}
+
+	for _, item := range expected {
+		path, err := govfx.ParsePath(item.path)
+		if err != nil {
+			t.Fatalf("Should have parsed %q: %s", item.path, err)
+		}
+
+		if length := path.TotalLength(); math.Abs(length-item.length) > 0.05 {
+			t.Fatalf("Should have measured %q as %.3f but got %.3f", item.path, item.length, length)
+		}
+
+		half := path.PointAtLength(path.TotalLength() / 2)
+		if math.Abs(half.X-item.half.X) > 0.05 || math.Abs(half.Y-item.half.Y) > 0.05 {
+			t.Fatalf("Should have found %+v halfway along %q but got %+v", item.half, item.path, half)
+		}
+	}
+
+	for _, value := range []string{"", "L10,10", "M0,0 L10", "M0,0 Z 10,10", "M0,0 X10,10", "M0,0 A10,10 0 2,0 10,10"} {
+		if _, err := govfx.ParsePath(value); err != govfx.ErrInvalidPath {
+			t.Fatalf("Should have failed to parse %q", value)
+		}
+	}
+}
+
+// TestSVGPathEaser validates easing along a svg path.
+func TestSVGPathEaser(t *testing.T) {
+	easer, err := govfx.NewSVGPathEaser(govfx.SVGConfig{
+		SVGPath: "M0,100 L50,50 L100,0",
+		Width:   100,
+		Height:  100,
+	})
+
+	if err != nil {
+		t.Fatalf("Should have created the easer: %s", err)
+	}
+
+	for _, at := range []float64{0, 0.1, 0.25, 0.5, 0.8, 1} {
+		if got := easer.Ease(at); math.Abs(got-at) > 1e-9 {
+			t.Fatalf("Should have eased along the diagonal at %.2f but got %.4f", at, got)
+		}
+	}
+
+	if _, ok := easer.Sample(1.5); ok {
+		t.Fatal("Should have failed to sample beyond the path")
+	}
+
+	curve, _ := govfx.NewSVGPathEaser(govfx.SVGConfig{
+		SVGPath:      "M0,100 C40,100 50,90 50,50 C50,10 60,0 100,0",
+		Width:        100,
+		Height:       100,
+		SamplingSize: 300,
+	})
+
+	if got := curve.Ease(0.5); math.Abs(got-0.5) > 0.01 {
+		t.Fatalf("Should have reached the middle of the curve halfway but got %.4f", got)
+	}
+
+	if curve.Ease(0.25) >= 0.25 || curve.Ease(0.75) <= 0.75 {
+		t.Fatal("Should have eased in and out along the curve")
+	}
+
+	if _, err := govfx.NewSVGPathEaser(govfx.SVGConfig{SVGPath: "Q", Width: 100, Height: 100}); err != govfx.ErrInvalidPath {
+		t.Fatal("Should have failed to create a easer for a invalid path")
+	}
+
+	for _, box := range [][2]int{{0, 100}, {100, 0}, {-10, 100}} {
+		_, err := govfx.NewSVGPathEaser(govfx.SVGConfig{SVGPath: "M0,100 L100,0", Width: box[0], Height: box[1]})
+		if err != govfx.ErrInvalidSVGBox {
+			t.Fatalf("Should have failed to create a easer for a box of %v", box)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package govfx
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//==============================================================================
+
+// ErrInvalidTarget defines the error returned when a target value can not be
+// parsed.
+var ErrInvalidTarget = errors.New("Invalid Target")
+
+// relativeOperators defines the operators of relative target values.
+var relativeOperators = []string{"+=", "-=", "*=", "/="}
+
+// SplitOperator returns the relative operator at the start of the value (eg
+// "+=" for "+=100px") with the rest of the value, else an empty operator with
+// the value as is.
+func SplitOperator(value string) (string, string) {
+	value = strings.TrimSpace(value)
+
+	for _, op := range relativeOperators {
+		if strings.HasPrefix(value, op) {
+			return op, strings.TrimSpace(value[len(op):])
+		}
+	}
+
+	return "", value
+}
+
+// ApplyOperator returns the result of the relative operator applied to the
+// current value with the provided value, where an empty or unknown operator
+// returns the value as is.
+func ApplyOperator(op string, current float64, value float64) float64 {
+	switch op {
+	case "+=":
+		return current + value
+	case "-=":
+		return current - value
+	case "*=":
+		return current * value
+	case "/=":
+		if value == 0 {
+			return current
+		}
+
+		return current / value
+	}
+
+	return value
+}
+
+//==============================================================================
+
+// Target defines the target value of a sequence, which is either absolute or
+// relative to the current value of the element through the "+=", "-=", "*="
+// or "/=" operators, eg "+=100" or "*=2". Absolute, "+=" and "-=" targets can
+// be css lengths (eg "200px" or "+=2em"), whose unit is kept for the sequence
+// to resolve within the context of the element. Target fields of a sequence
+// are set from both numbers and strings by Merge.
+type Target struct {
+	Op    string
+	Value float64
+	Unit  string
+}
+
+// ParseTarget returns the Target for the provided number, string or Target.
+func ParseTarget(value interface{}) (Target, error) {
+	switch val := value.(type) {
+	case Target:
+		return val, nil
+	case string:
+		op, rest := SplitOperator(val)
+
+		length, err := ParseLength(rest)
+		if err != nil || length.IsCalc() {
+			return Target{}, ErrInvalidTarget
+		}
+
+		// A factor has no unit, hence "*=2px" is not a valid target.
+		if length.Unit != "" && (op == "*=" || op == "/=") {
+			return Target{}, ErrInvalidTarget
+		}
+
+		return Target{Op: op, Value: length.Value, Unit: length.Unit}, nil
+	}
+
+	num, err := strconv.ParseFloat(fmt.Sprint(value), 64)
+	if err != nil {
+		return Target{}, ErrInvalidTarget
+	}
+
+	return Target{Value: num}, nil
+}
+
+// Length returns the value of the target as a length in the unit of the
+// target.
+func (t Target) Length() Length {
+	return Length{Value: t.Value, Unit: t.Unit}
+}
+
+// IsRelative returns true/false if the target is relative to the current value.
+func (t Target) IsRelative() bool {
+	return t.Op != ""
+}
+
+// Resolve returns the absolute target value for the current value of the
+// element.
+func (t Target) Resolve(current float64) float64 {
+	return ApplyOperator(t.Op, current, t.Value)
+}
+
+// String returns the target in the format it is parsed from.
+func (t Target) String() string {
+	return t.Op + strconv.FormatFloat(t.Value, 'f', -1, 64) + t.Unit
+}
+
+//==============================================================================
+
+// ValueFunc defines a function-valued property of a Value, which is resolved
+// for every animated element with its index among the animated elements.
+type ValueFunc func(index int, elem Elemental) interface{}
+
+// Resolve returns a copy of the values with every function-valued property,
+// including those of keyframes, resolved for the element at the giving index.
+func (v Values) Resolve(index int, elem Elemental) Values {
+	resolved := make(Values, len(v))
+
+	for ind, val := range v {
+		resolved[ind] = val.Resolve(index, elem)
+	}
+
+	return resolved
+}
+
+// Resolve returns a copy of the value with every function-valued property,
+// including those of keyframes, resolved for the element at the giving index.
+func (v Value) Resolve(index int, elem Elemental) Value {
+	resolved := make(Value, len(v))
+
+	for key, val := range v {
+		if key == KeyframesAttributeName {
+			if frames, ok := toValues(val); ok {
+				resolved[key] = frames.Resolve(index, elem)
+				continue
+			}
+		}
+
+		switch fn := val.(type) {
+		case ValueFunc:
+			resolved[key] = fn(index, elem)
+		case func(int, Elemental) interface{}:
+			resolved[key] = fn(index, elem)
+		default:
+			resolved[key] = val
+		}
+	}
+
+	return resolved
+}
+
+//==============================================================================
@@ -0,0 +1,179 @@
+package govfx_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/influx6/govfx"
+)
+
+// TestParseTarget validates the parsing and resolution of target values.
+func TestParseTarget(t *testing.T) {
+	expected := []struct {
+		value  interface{}
+		target string
+		from   float64
+		result float64
+	}{
+		{100, "100", 20, 100},
+		{"-2.5", "-2.5", 20, -2.5},
+		{"+=100", "+=100", 20, 120},
+		{"-= 5", "-=5", 20, 15},
+		{"*=2", "*=2", 20, 40},
+		{"/=4", "/=4", 20, 5},
+		{govfx.Target{Op: "+=", Value: 1}, "+=1", 20, 21},
+		{"200px", "200px", 20, 200},
+		{"+=2.5EM", "+=2.5em", 20, 22.5},
+	}
+
+	for _, item := range expected {
+		target, err := govfx.ParseTarget(item.value)
+		if err != nil {
+			t.Fatalf("Should have parsed %v: %s", item.value, err)
+		}
+
+		if got := target.String(); got != item.target {
+			t.Fatalf("Should have parsed %v as %q but got %q", item.value, item.target, got)
+		}
+
+		if got := target.Resolve(item.from); got != item.result {
+			t.Fatalf("Should have resolved %v from %.2f to %.2f but got %.2f", item.value, item.from, item.result, got)
+		}
+	}
+
+	for _, value := range []interface{}{"+=", "%=2", "ten", nil, "*=2px", "20deg", "calc(100% - 20px)"} {
+		if _, err := govfx.ParseTarget(value); err != govfx.ErrInvalidTarget {
+			t.Fatalf("Should have failed to parse %v", value)
+		}
+	}
+
+	if _, err := govfx.NewSequence("left", govfx.Value{"value": "ten"}); err != govfx.ErrInvalidTarget {
+		t.Fatalf("Should have failed to create a sequence with a invalid target: %v", err)
+	}
+}
+
+// TestLengthTargets validates the animation of targets with units, which are
+// written out in their unit from the current value converted into it.
+func TestLengthTargets(t *testing.T) {
+	doc := newDocument(1)
+	doc.Body().SetAttribute("style", "font-size: 20px")
+	doc.AddRule(".item", "left: 10px; margin-top: 1em; font-size: 10px")
+
+	items := govfx.QuerySelectorAll(".item")
+
+	timeline, err := govfx.Animate(govfx.Stat{
+		Duration: 1 * time.Second,
+		Clock:    govfx.NewManualClock(time.Unix(0, 0)),
+	}, govfx.Values{
+		{"value": "200px", "animate": "left", "easing": "linear"},
+		{"value": "+=10px", "animate": "margin-top", "easing": "linear"},
+		{"value": "2em", "animate": "font-size", "easing": "linear"},
+		{"value": "50%", "animate": "translate-x", "easing": "linear"},
+	}, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timeline.SeekProgress(0.5)
+
+	// The font size moves from 10px (0.5em of the body) towards 2em, while
+	// the margin moves from 1em (10px) by 10px.
+	expected := "left: 105px; margin-top: 15px; font-size: 1.25em; transform: translateX(25px)"
+	if style := items[0].GetAttribute("style"); style != expected {
+		t.Fatalf("Should have interpolated the targets:\n%q\n%q", style, expected)
+	}
+
+	timeline.SeekProgress(1)
+
+	expected = "left: 200px; margin-top: 20px; font-size: 2em; transform: translateX(50px)"
+	if style := items[0].GetAttribute("style"); style != expected {
+		t.Fatalf("Should have reached the targets:\n%q\n%q", style, expected)
+	}
+}
+
+// TestRelativeTargets validates the animation of relative and function-valued
+// targets.
+func TestRelativeTargets(t *testing.T) {
+	doc := newDocument(3)
+	doc.AddRule(".item", "transform: translateX(20px); margin-left: 10px")
+	doc.Body().Children()[2].SetAttribute("style", "width: 40px")
+
+	items := govfx.QuerySelectorAll(".item")
+
+	timeline, err := govfx.Animate(govfx.Stat{
+		Duration: 1 * time.Second,
+		Clock:    govfx.NewManualClock(time.Unix(0, 0)),
+	}, govfx.Values{
+		{"value": "+=100", "animate": "translate-x", "easing": "linear"},
+		{"value": "*=2", "animate": "width", "easing": "linear"},
+		{
+			"animate": "margin-left",
+			"easing":  "linear",
+			"value": func(index int, elem govfx.Elemental) interface{} {
+				return index * 10
+			},
+		},
+	}, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timeline.SeekProgress(1)
+
+	expected := []string{
+		"width: 200px; margin-left: 0px; transform: translateX(120px)",
+		"width: 200px; margin-left: 10px; transform: translateX(120px)",
+		"width: 80px; margin-left: 20px; transform: translateX(120px)",
+	}
+
+	for ind, item := range items {
+		if style := item.GetAttribute("style"); style != expected[ind] {
+			t.Fatalf("Should have resolved the targets of element %d:\n%q\n%q", ind, style, expected[ind])
+		}
+	}
+}
+
+// TestRelativeKeyframes validates relative targets within keyframes, which
+// are relative to the state of the previous keyframe.
+func TestRelativeKeyframes(t *testing.T) {
+	newDocument(2)
+
+	items := govfx.QuerySelectorAll(".item")
+	timeline, err := govfx.Animate(govfx.Stat{
+		Duration: 1 * time.Second,
+		Clock:    govfx.NewManualClock(time.Unix(0, 0)),
+	}, govfx.Values{
+		{
+			"animate": "translate-y",
+			"easing":  "linear",
+			"keyframes": []interface{}{
+				map[string]interface{}{"at": 0.5, "value": "+=50"},
+				map[string]interface{}{"at": 1, "value": govfx.ValueFunc(func(index int, elem govfx.Elemental) interface{} {
+					return fmt.Sprintf("-=%d", index*20)
+				})},
+			},
+		},
+	}, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []struct {
+		at     float64
+		values []string
+	}{
+		{0.5, []string{"transform: translateY(50px)", "transform: translateY(50px)"}},
+		{1, []string{"transform: translateY(50px)", "transform: translateY(30px)"}},
+	}
+
+	for _, step := range expected {
+		timeline.SeekProgress(step.at)
+
+		for ind, item := range items {
+			if style := item.GetAttribute("style"); style != step.values[ind] {
+				t.Fatalf("Should have resolved the keyframes of element %d at %.2f:\n%q\n%q", ind, step.at, style, step.values[ind])
+			}
+		}
+	}
+}
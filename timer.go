@@ -1,9 +1,12 @@
 package govfx
 
 import (
+	"math"
 	"sync"
 	"sync/atomic"
 	"time"
+
+	"github.com/influx6/faux/loop"
 )
 
 //==============================================================================
@@ -31,8 +34,8 @@ type TimelineBehaviour interface {
 	Reset()
 	Completed(int)
 	Render(interpolate float64)
-	UpdateReverse(delta float64)
 	RenderReverse(interpolate float64)
+	UpdateReverse(delta, progress float64, timeline float64)
 	Update(delta, progress float64, timeline float64)
 }
 
@@ -43,6 +46,34 @@ type TimelineBehaviourSimulationFlag interface {
 	SimulationOFF()
 }
 
+// TimelineBehaviourSeeker defines a interface for TimelineBehaviours which can
+// be moved to their exact state at a specific moment of their timeline. The
+// elapsed time and total duration are in seconds, where step is the fixed
+// update step used by the timer.
+type TimelineBehaviourSeeker interface {
+	Seek(step float64, elapsed float64, total float64)
+}
+
+// TimelineBehaviourSettler defines a interface for TimelineBehaviours which
+// can be set to their exact end state once their run ends, even when they
+// ignore updates after completing. The total duration is in seconds, where
+// step is the fixed update step used by the timer.
+type TimelineBehaviourSettler interface {
+	Settle(step float64, total float64)
+}
+
+// TimelineBehaviourCanceler defines a interface for TimelineBehaviours which
+// can undo their effects when their timeline is cancelled.
+type TimelineBehaviourCanceler interface {
+	Cancel()
+}
+
+// TimelineBehaviourWriteCounter defines a interface for TimelineBehaviours
+// which count the writes they make to the DOM.
+type TimelineBehaviourWriteCounter interface {
+	Writes() WriteCount
+}
+
 // Timeline defines a struct to manage the behaviour of a animation frame.
 type Timeline struct {
 	stat Stat
@@ -84,13 +115,18 @@ type Timeline struct {
 func NewTimeline(mt ModeTimer, t TimelineBehaviour, stat Stat) *Timeline {
 	tm := Timeline{tmMod: mt, stat: stat, tb: t, simulated: make(chan struct{})}
 
+	if tm.tmMod.Rate == 0 {
+		tm.tmMod.Rate = 1
+	}
+
 	// Setup loop flags.
 	tm.loop = int64(stat.Loop)
 	tm.loops = (stat.Loop < 0 || stat.Loop > 0)
 	tm.loopInfinite = stat.Loop < 0
 
-	// Set up core variables.
-	tm.timeline = stat.Duration + stat.Delay
+	// Set up core variables, the delay is handled by the timer, hence the
+	// timeline only covers the duration.
+	tm.timeline = stat.Duration
 
 	return &tm
 }
@@ -142,6 +178,87 @@ func (t *Timeline) Pause() {
 	t.timer.Pause()
 }
 
+// Cancel stops the timeline without ending it, restoring the inline styles
+// changed by its sequences to their values before the animation. Unlike an
+// animation which ends, no end event is emitted.
+func (t *Timeline) Cancel() {
+	if atomic.LoadInt64(&t.beating) > 0 && t.timer != nil {
+		t.timer.Pause()
+		StopTimer(t.timer)
+	}
+
+	atomic.StoreInt64(&t.beating, 0)
+	atomic.StoreInt64(&t.dead, 1)
+
+	if cn, ok := t.tb.(TimelineBehaviourCanceler); ok {
+		cn.Cancel()
+	}
+}
+
+// Writes returns the number of writes made to the DOM by the timeline.
+func (t *Timeline) Writes() WriteCount {
+	if wc, ok := t.tb.(TimelineBehaviourWriteCounter); ok {
+		return wc.Writes()
+	}
+
+	return WriteCount{}
+}
+
+// Seek moves the timeline to the provided moment from its start, including its
+// delay and reversed run, setting every sequence to its exact state for that
+// moment. If the timeline is running, it continues playing from that moment.
+func (t *Timeline) Seek(d time.Duration) {
+	if d < 0 {
+		d = 0
+	}
+
+	if max := t.stat.Delay + t.span(); d > max {
+		d = max
+	}
+
+	elapsed := d - t.stat.Delay
+	if elapsed < 0 {
+		elapsed = 0
+	}
+
+	t.completed = false
+	t.reversed = false
+	t.reversedDone = false
+	t.reclocking = false
+	t.progress = elapsed.Seconds()
+
+	// Within the reversed run, the sequences are at their state mirrored
+	// from the end of the duration.
+	at := elapsed
+	if elapsed > t.timeline {
+		at = 2*t.timeline - elapsed
+		t.completed = true
+		t.reversed = true
+	}
+
+	if sk, ok := t.tb.(TimelineBehaviourSeeker); ok {
+		sk.Seek(t.tmMod.MaxMSPerUpdate, at.Seconds(), t.timeline.Seconds())
+	}
+
+	if atomic.LoadInt64(&t.beating) > 0 && atomic.LoadInt64(&t.dead) < 1 && t.timer != nil {
+		t.timer.Seek(d)
+	}
+}
+
+// SeekProgress moves the timeline to the provided progress between 0 and 1
+// of its duration, excluding its delay.
+func (t *Timeline) SeekProgress(progress float64) {
+	if progress < 0 {
+		progress = 0
+	}
+
+	if progress > 1 {
+		progress = 1
+	}
+
+	t.Seek(t.stat.Delay + time.Duration(progress*float64(t.stat.Duration)))
+}
+
 // Start loads the timeline animation to the run loop.
 func (t *Timeline) Start() {
 	if atomic.LoadInt64(&t.paused) > 0 {
@@ -149,10 +266,44 @@ func (t *Timeline) Start() {
 	}
 
 	atomic.StoreInt64(&t.beating, 1)
+	t.runTimer()
+}
+
+// runTimer creates a new timer for the timeline and registers it with the
+// Scheduler of the loop engine, shared by all timelines on that engine. When
+// the timeline plays backward, the timer starts at the end of the timeline.
+func (t *Timeline) runTimer() {
 	t.timer = NewTimer(t, t.tmMod)
-	stopCache.Add(t.timer, engine.Loop(func(delta float64) {
+
+	if t.backward() {
+		t.timer.Seek(t.stat.Delay + t.span())
+	}
+
+	stopCache.Add(t.timer, SchedulerOf(t.tmMod.engine()).Tick(func() {
 		t.timer.Update()
-	}, 0))
+	}))
+}
+
+// SetRate sets the playback rate of the timeline, where 1 is normal speed,
+// 0.5 half speed, 2 double speed and a negative rate plays the timeline
+// backward. The rate can be changed while the timeline is running.
+func (t *Timeline) SetRate(rate float64) {
+	t.tmMod.Rate = rate
+
+	if t.timer != nil {
+		t.timer.SetRate(rate)
+	}
+}
+
+// Rate returns the playback rate of the timeline.
+func (t *Timeline) Rate() float64 {
+	return t.tmMod.Rate
+}
+
+// backward returns true/false if the timeline plays backward, either from its
+// rate or from the global time scale.
+func (t *Timeline) backward() bool {
+	return t.tmMod.Rate*TimeScale() < 0
 }
 
 // Begin sets the timeline ready to begin to clocking its behaviours
@@ -166,7 +317,7 @@ func (t *Timeline) Begin(begin time.Time) {
 
 	if fb, ok := t.tb.(TimelineEmitable); ok {
 		t.beginOnce.Do(func() {
-			fb.EmitBegin(time.Since(begin).Seconds())
+			fb.EmitBegin(clockOf(t.tmMod.Clock).Now().Sub(begin).Seconds())
 		})
 	}
 }
@@ -206,10 +357,7 @@ func (t *Timeline) loopRun() {
 	t.reversedDone = false
 
 	// Create a new timer and run the clock.
-	t.timer = NewTimer(t, t.tmMod)
-	stopCache.Add(t.timer, engine.Loop(func(delta float64) {
-		t.timer.Update()
-	}, 0))
+	t.runTimer()
 
 	t.reclocking = true
 }
@@ -220,11 +368,14 @@ func (t *Timeline) Update(delta float64, progress float64) {
 		return
 	}
 
-	if t.reclocking && t.timeline.Seconds() <= progress {
+	total := t.timeline.Seconds()
+	span := t.span().Seconds()
+
+	if t.reclocking && span <= progress {
 		return
 	}
 
-	if t.reclocking && t.timeline.Seconds() > progress {
+	if t.reclocking && span > progress {
 		t.reclocking = false
 		return
 	}
@@ -257,38 +408,73 @@ func (t *Timeline) Update(delta float64, progress float64) {
 
 	}
 
-	// We check the timelines to see if its matching what's expected.
-	if t.timeline.Seconds() < progress || t.timeline.Seconds() < (progress+t.tmMod.MaxMSPerUpdate) {
-		if !t.completed {
-			t.completed = true
-			t.tb.Completed(0)
+	forward := !t.backward()
 
-			t.simulatedOnce.Do(func() {
-				close(t.simulated)
-			})
+	// When playing backward, the timeline completes once it reaches its start,
+	// else it completes once the forward run reaches its end.
+	completing := !forward && progress <= 0
+	if forward && (total < progress || total < (progress+t.tmMod.MaxMSPerUpdate)) {
+		completing = true
+	}
 
-			if t.simulationON {
-				if sim, ok := t.tb.(TimelineBehaviourSimulationFlag); ok {
-					sim.SimulationOFF()
-				}
+	if completing && !t.completed {
+		t.completed = true
+		t.tb.Completed(0)
 
-				t.simulationON = false
+		t.simulatedOnce.Do(func() {
+			close(t.simulated)
+		})
 
-				// t.completed = false
-				atomic.StoreInt64(&t.beating, 0)
-				t.tb.Reset()
-				return
+		if t.simulationON {
+			if sim, ok := t.tb.(TimelineBehaviourSimulationFlag); ok {
+				sim.SimulationOFF()
 			}
+
+			t.simulationON = false
+
+			// t.completed = false
+			atomic.StoreInt64(&t.beating, 0)
+			t.tb.Reset()
+			return
 		}
+	}
 
-		if t.stat.Reverse {
-			if !t.reversed && !t.tb.Done() {
-				t.reversed = true
+	// We check the timelines to see if its matching what's expected, where a
+	// reversed timeline spans twice its duration.
+	ended := !forward && progress <= 0
+	if forward && (span < progress || span < (progress+t.tmMod.MaxMSPerUpdate)) {
+		ended = true
+	}
+
+	if ended {
+
+		switch {
+
+		// Settle the sequences at their start, where a backward run ends.
+		case !forward:
+			t.reversed = false
+
+			if sk, ok := t.tb.(TimelineBehaviourSeeker); ok {
+				sk.Seek(t.tmMod.MaxMSPerUpdate, 0, total)
+			} else {
+				t.tb.Update(delta, 0, 0)
 			}
 
-			if t.reversed && !t.tb.Done() {
-				t.tb.UpdateReverse(delta)
-				return
+		// Settle the sequences at the end of the run.
+		case !t.stat.Reverse:
+			if st, ok := t.tb.(TimelineBehaviourSettler); ok {
+				st.Settle(t.tmMod.MaxMSPerUpdate, total)
+			} else {
+				t.tb.Update(delta, total, 1)
+			}
+		}
+
+		if t.stat.Reverse {
+
+			// Settle the sequences at the end of the reversed run.
+			if forward {
+				t.reversed = true
+				t.tb.UpdateReverse(delta, progress, 1)
 			}
 
 			t.reversedDone = true
@@ -329,7 +515,36 @@ func (t *Timeline) Update(delta float64, progress float64) {
 		return
 	}
 
-	t.tb.Update(delta, progress, progress/t.timeline.Seconds())
+	// Past its duration a reversed timeline plays its sequences backward.
+	if t.stat.Reverse && progress > total {
+		t.reversed = true
+		t.tb.UpdateReverse(delta, progress, (progress-total)/total)
+		return
+	}
+
+	t.reversed = false
+	t.tb.Update(delta, progress, progress/total)
+}
+
+// Length returns the total length of the timeline including the delay and
+// reversed run of every loop, where infinite loops count as a single run.
+func (t *Timeline) Length() time.Duration {
+	runs := 1
+	if t.stat.Loop > 1 {
+		runs = t.stat.Loop
+	}
+
+	return time.Duration(runs) * (t.stat.Delay + t.span())
+}
+
+// span returns the total length of a single run of the timeline, which
+// includes the reversed run if the timeline reverses.
+func (t *Timeline) span() time.Duration {
+	if t.stat.Reverse {
+		return 2 * t.timeline
+	}
+
+	return t.timeline
 }
 
 //==============================================================================
@@ -350,10 +565,13 @@ type Timer interface {
 }
 
 // Timeable defines an interface that defines a Timer confirming
-// structure with the ability to set the TimeBehaviour to use.
+// structure with the ability to set the TimeBehaviour to use and to move
+// to a specific moment from its start.
 type Timeable interface {
 	Timer
 	Use(TimeBehaviour)
+	Seek(time.Duration)
+	SetRate(float64)
 }
 
 // maxMSPerUpdate defines the maximum tick for which our updates
@@ -362,17 +580,37 @@ var maxMSPerUpdate = 0.01
 var maxUpdateRuns = 0.25
 
 // ModeTimer defines a configuration for seting the behaviour of a
-// timer loop.
+// timer loop. If no Clock is provided, the SystemClock is used. A zero Rate
+// is treated as the normal rate of 1.
 type ModeTimer struct {
 	Delay             time.Duration
 	MaxMSPerUpdate    float64
 	MaxDeltaPerUpdate float64
+	Clock             Clock
+	Rate              float64
+}
+
+// engine returns the loop engine to be used with this mode, if the Clock
+// implements the loop.GameEngine then it is used else the global engine is
+// returned.
+func (m ModeTimer) engine() loop.GameEngine {
+	if ge, ok := m.Clock.(loop.GameEngine); ok {
+		return ge
+	}
+
+	return engine
 }
 
 // NewTimer returns a new timer struct which calculates the delta and elapse time
 // each calls of run.
 func NewTimer(b TimeBehaviour, mod ModeTimer) Timeable {
 	tm := timer{behaviour: b, mode: mod}
+
+	if mod.Rate == 0 {
+		mod.Rate = 1
+	}
+
+	tm.SetRate(mod.Rate)
 	return &tm
 }
 
@@ -404,7 +642,12 @@ type timer struct {
 
 	run      int64
 	stop     int64
+	rate     uint64
 	skipTick float64
+
+	seeked bool
+	seekTo time.Duration
+	seeks  int64
 }
 
 // Use sets the behaviour to be used by the timer for its update
@@ -416,33 +659,76 @@ func (t *timer) Use(d TimeBehaviour) {
 }
 
 // Update updates the timers internal clocks, calculating the necessary durations
-// and delta values
+// and delta values. The behaviour is called without holding the timer lock,
+// hence it and its listeners can seek, pause or resume the timer.
 func (t *timer) Update() {
-	t.ml.RLock()
-	defer t.ml.RUnlock()
+	t.ml.Lock()
+	behaviour := t.behaviour
+	starting := behaviour != nil && !t.hasBegun()
+	if starting {
+		t.init()
+	}
+	start := t.start
+	t.ml.Unlock()
 
-	if t.behaviour == nil {
+	if behaviour == nil {
 		return
 	}
 
-	if !t.hasBegun() {
-		t.init()
+	if so, ok := behaviour.(StartableBehaviour); ok && starting {
+		so.Begin(start)
 	}
 
 	if atomic.LoadInt64(&t.stop) > 0 {
 		return
 	}
 
-	now := time.Now()
+	steps, interpolate, seeks, ok := t.advance()
+	if !ok {
+		return
+	}
+
+	for _, progress := range steps {
+		behaviour.Update(t.mode.MaxMSPerUpdate, progress)
+
+		// A seek made while updating moved the clocks of the timer, hence
+		// the steps left from before the seek are dropped.
+		if atomic.LoadInt64(&t.seeks) != seeks {
+			return
+		}
+	}
+
+	behaviour.Render(interpolate)
+}
+
+// advance moves the internal clocks of the timer to the current time,
+// returning the progress of every fixed step to update, the interpolation
+// to render with and the number of seeks made so far. Returns false if the
+// timer is still within its delay.
+func (t *timer) advance() ([]float64, float64, int64, bool) {
+	t.ml.Lock()
+	defer t.ml.Unlock()
+
+	now := clockOf(t.mode.Clock).Now()
+
+	// Scale the elapsed time by the timer's rate and the global time scale,
+	// where a negative scale moves the timer backward.
+	scale := t.Rate() * TimeScale()
+	direction := 1.0
+
+	if scale < 0 {
+		scale = -scale
+		direction = -1
+	}
 
 	t.lastDelta = t.delta
-	t.delta = now.Sub(t.previous)
+	t.delta = time.Duration(float64(now.Sub(t.previous)) * scale)
 	t.previous = now
 
 	t.progress = t.progress.Add(t.delta)
 
 	if t.progress.Before(t.initial) {
-		return
+		return nil, 0, 0, false
 	}
 
 	var dt float64
@@ -455,15 +741,56 @@ func (t *timer) Update() {
 
 	t.accumulator += dt
 
+	var steps []float64
+
 	for t.accumulator >= t.mode.MaxMSPerUpdate {
-		t.behaviour.Update(t.mode.MaxMSPerUpdate, t.totaldelta)
-		t.totaldelta += t.mode.MaxMSPerUpdate
+		steps = append(steps, t.totaldelta)
+		t.totaldelta += direction * t.mode.MaxMSPerUpdate
 		t.accumulator -= t.mode.MaxMSPerUpdate
 	}
 
-	interpolate := t.accumulator / t.mode.MaxMSPerUpdate
+	return steps, t.accumulator / t.mode.MaxMSPerUpdate, atomic.LoadInt64(&t.seeks), true
+}
+
+// SetRate sets the rate at which the timer's time elapses, a negative rate
+// moves the timer backward.
+func (t *timer) SetRate(rate float64) {
+	atomic.StoreUint64(&t.rate, math.Float64bits(rate))
+}
+
+// Rate returns the rate at which the timer's time elapses.
+func (t *timer) Rate() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&t.rate))
+}
+
+// Seek moves the timer to the provided moment from its start, including its
+// delay. If the timer has not begun, the seek is applied once it does.
+func (t *timer) Seek(d time.Duration) {
+	t.ml.Lock()
+	defer t.ml.Unlock()
+
+	t.seeked = true
+	t.seekTo = d
+
+	if t.hasBegun() {
+		t.applySeek()
+	}
+}
+
+// applySeek sets the internal clocks of the timer to match the seeked moment.
+func (t *timer) applySeek() {
+	t.seeked = false
+	atomic.AddInt64(&t.seeks, 1)
+	t.previous = clockOf(t.mode.Clock).Now()
+	t.progress = t.start.Add(t.seekTo)
+	t.accumulator = 0
+
+	elapsed := t.seekTo - t.mode.Delay
+	if elapsed < 0 {
+		elapsed = 0
+	}
 
-	t.behaviour.Render(interpolate)
+	t.totaldelta = elapsed.Seconds()
 }
 
 // Pause sets the timer loop as inactive.
@@ -471,25 +798,27 @@ func (t *timer) Pause() {
 	atomic.StoreInt64(&t.stop, 1)
 }
 
-// Resume resets the timer loop as active.
+// Resume resets the timer loop as active. The time spent paused is not
+// accounted for on the next update.
 func (t *timer) Resume() {
+	t.ml.Lock()
+	t.previous = clockOf(t.mode.Clock).Now()
+	t.ml.Unlock()
+
 	atomic.StoreInt64(&t.stop, 0)
 }
 
-// init initializes the details of the time for work.
+// init initializes the details of the time for work, expecting the timer lock
+// to be held by the caller.
 func (t *timer) init() {
-	t.start = time.Now()
+	t.start = clockOf(t.mode.Clock).Now()
 	t.previous = t.start
 	t.progress = t.start
 	t.initial = t.start.Add(t.mode.Delay)
 	atomic.StoreInt64(&t.run, 1)
 
-	t.ml.RLock()
-	defer t.ml.RUnlock()
-	if t.behaviour != nil {
-		if so, ok := t.behaviour.(StartableBehaviour); ok {
-			so.Begin(t.start)
-		}
+	if t.seeked {
+		t.applySeek()
 	}
 }
 
@@ -1,46 +1,504 @@
 package govfx_test
 
 import (
-	"fmt"
 	"testing"
 	"time"
 
 	"github.com/influx6/govfx"
 )
 
-type mob struct{}
-
-var renders int
-var updates int
+type mob struct {
+	renders int
+	updates int
+}
 
-func (mob) Render(dt float64) {
-	updates = 0
-	fmt.Printf(".{Render %d -> Interpolate %.4f}.\n", renders, dt)
-	renders++
-	<-time.After(100 * time.Millisecond)
+func (m *mob) Render(dt float64) {
+	m.renders++
 }
 
-func (mob) Update(dt float64, totaltime float64) {
-	updates++
+func (m *mob) Update(dt float64, totaltime float64) {
+	m.updates++
 }
 
 // TestTimer validates the behaviour of the Timer API.
 func TestTimer(t *testing.T) {
 	var m mob
 
+	clock := govfx.NewManualClock(time.Now())
+
 	mt := govfx.NewTimer(&m, govfx.ModeTimer{
 		Delay:             1 * time.Second,
-		MaxMSPerUpdate:    0.01,
+		MaxMSPerUpdate:    0.0625,
 		MaxDeltaPerUpdate: 1.5,
+		Clock:             clock,
 	})
 
 	defer govfx.StopTimer(mt)
 
-	for i := 50; i > 0; i-- {
+	mt.Update()
+
+	// Within the delay period no update or render should occur.
+	for i := 0; i < 7; i++ {
+		clock.Advance(125 * time.Millisecond)
 		mt.Update()
-		time.Sleep(100 * time.Millisecond)
-		if i > 20 {
-			mt.Pause()
+	}
+
+	if m.updates != 0 || m.renders != 0 {
+		t.Fatalf("Should have no updates or renders within delay: %d updates, %d renders", m.updates, m.renders)
+	}
+
+	// Each 125ms step should provide exactly two fixed updates.
+	for i := 0; i < 4; i++ {
+		clock.Advance(125 * time.Millisecond)
+		mt.Update()
+	}
+
+	if m.updates != 8 || m.renders != 4 {
+		t.Fatalf("Should have 8 updates and 4 renders: %d updates, %d renders", m.updates, m.renders)
+	}
+
+	// A paused timer must not update.
+	mt.Pause()
+	clock.Advance(125 * time.Millisecond)
+	mt.Update()
+
+	if m.updates != 8 {
+		t.Fatalf("Should have no new updates when paused: %d updates", m.updates)
+	}
+
+	mt.Resume()
+	clock.Advance(125 * time.Millisecond)
+	mt.Update()
+
+	if m.updates != 10 {
+		t.Fatalf("Should have resumed updates: %d updates", m.updates)
+	}
+}
+
+// runTimeline steps a timeline with delay, loop and reverse to its end
+// returning the recorded styles of the element for every frame.
+func runTimeline(t *testing.T) ([]string, time.Duration) {
+	newDocument(1)
+
+	var begins, ends int
+	var frames []string
+
+	clock := govfx.NewManualClock(time.Unix(0, 0))
+	items := govfx.QuerySelectorAll(".item")
+
+	timeline, err := govfx.Animate(govfx.Stat{
+		Duration: 1 * time.Second,
+		Delay:    500 * time.Millisecond,
+		Loop:     2,
+		Reverse:  true,
+		Clock:    clock,
+		Begin:    govfx.NewListener(func(float64) { begins++ }),
+		End:      govfx.NewListener(func(float64) { ends++ }),
+	}, govfx.Values{
+		{"value": 500, "animate": "width", "easing": "linear"},
+	}, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timeline.Start()
+
+	for i := 0; i < 1000 && ends == 0; i++ {
+		clock.Step(time.Second / 60)
+		frames = append(frames, items[0].GetAttribute("style"))
+	}
+
+	if begins != 1 || ends != 1 {
+		t.Fatalf("Should have begun and ended once: %d begins, %d ends", begins, ends)
+	}
+
+	if clock.Loops() != 0 {
+		t.Fatalf("Should have no registered loops after end: %d", clock.Loops())
+	}
+
+	return frames, clock.Now().Sub(time.Unix(0, 0))
+}
+
+// TestTimelineDeterministic validates that timelines stepped with a manual
+// clock are reproducible.
+func TestTimelineDeterministic(t *testing.T) {
+	first, firstEnd := runTimeline(t)
+	second, secondEnd := runTimeline(t)
+
+	if firstEnd != secondEnd || len(first) != len(second) {
+		t.Fatalf("Should have ended at the same moment: %s and %s", firstEnd, secondEnd)
+	}
+
+	if firstEnd < 3*time.Second {
+		t.Fatalf("Should have ran for at least two loops with delay: %s", firstEnd)
+	}
+
+	for ind := range first {
+		if first[ind] != second[ind] {
+			t.Fatalf("Should have rendered frame %d identically: %q and %q", ind, first[ind], second[ind])
 		}
 	}
 }
+
+// TestTimelineSeek validates the seeking of a timeline both when idle and
+// when playing.
+func TestTimelineSeek(t *testing.T) {
+	newDocument(1)
+
+	var ends int
+
+	clock := govfx.NewManualClock(time.Unix(0, 0))
+	items := govfx.QuerySelectorAll(".item")
+
+	timeline, err := govfx.Animate(govfx.Stat{
+		Duration: 1 * time.Second,
+		Delay:    200 * time.Millisecond,
+		Clock:    clock,
+		End:      govfx.NewListener(func(float64) { ends++ }),
+	}, govfx.Values{
+		{"value": 500, "animate": "width", "easing": "linear"},
+	}, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timeline.SeekProgress(1)
+	if style := items[0].GetAttribute("style"); style != "width: 500px" {
+		t.Fatalf("Should have seeked to the end state: %q", style)
+	}
+
+	timeline.Seek(100 * time.Millisecond)
+	if style := items[0].GetAttribute("style"); style != "width: 100px" {
+		t.Fatalf("Should have seeked to the start state within the delay: %q", style)
+	}
+
+	timeline.Seek(700 * time.Millisecond)
+	middle := items[0].GetAttribute("style")
+
+	timeline.SeekProgress(0.5)
+	if style := items[0].GetAttribute("style"); style != middle {
+		t.Fatalf("Should have seeked to the same state: %q and %q", style, middle)
+	}
+
+	timeline.Start()
+	clock.Frames(30, time.Second/60)
+
+	timeline.SeekProgress(0)
+	if style := items[0].GetAttribute("style"); style != "width: 100px" {
+		t.Fatalf("Should have seeked back to the start state while playing: %q", style)
+	}
+
+	clock.Frames(60, time.Second/60)
+	if ends != 0 {
+		t.Fatal("Should not have ended before its duration after seeking back")
+	}
+
+	clock.Frames(60, time.Second/60)
+	if ends != 1 {
+		t.Fatal("Should have ended after its duration")
+	}
+}
+
+// progressBev defines a TimelineBehaviour which records the progress it
+// receives.
+type progressBev struct {
+	progress []float64
+}
+
+func (p *progressBev) Done() bool                    { return true }
+func (p *progressBev) Reset()                        {}
+func (p *progressBev) Completed(int)                 {}
+func (p *progressBev) Render(float64)                {}
+func (p *progressBev) UpdateReverse(_, _, _ float64) {}
+func (p *progressBev) RenderReverse(float64)         {}
+func (p *progressBev) Update(_, _, value float64)    { p.progress = append(p.progress, value) }
+
+// playFor runs a timeline at the given rate and returns the duration it ran
+// before ending, along with its behaviour.
+func playFor(rate float64) (time.Duration, *progressBev) {
+	var bev progressBev
+
+	clock := govfx.NewManualClock(time.Unix(0, 0))
+
+	timeline := govfx.NewTimeline(govfx.ModeTimer{
+		MaxMSPerUpdate:    0.01,
+		MaxDeltaPerUpdate: 2.5,
+		Clock:             clock,
+	}, &bev, govfx.Stat{
+		Duration: 1 * time.Second,
+	})
+
+	timeline.SetRate(rate)
+	timeline.Start()
+
+	for i := 0; i < 1000 && clock.Loops() > 0; i++ {
+		clock.Step(time.Second / 50)
+	}
+
+	if clock.Loops() > 0 {
+		return 0, &bev
+	}
+
+	return clock.Now().Sub(time.Unix(0, 0)), &bev
+}
+
+// TestTimelineRate validates the playback rate and global time scale of
+// timelines.
+func TestTimelineRate(t *testing.T) {
+	normal, _ := playFor(1)
+	double, _ := playFor(2)
+	half, _ := playFor(0.5)
+
+	if double >= normal || half <= normal {
+		t.Fatalf("Should have scaled the timeline: %s normal, %s double, %s half", normal, double, half)
+	}
+
+	if half < 1900*time.Millisecond || half > 2100*time.Millisecond {
+		t.Fatalf("Should have ran for about 2s at half speed: %s", half)
+	}
+
+	if double < 450*time.Millisecond || double > 550*time.Millisecond {
+		t.Fatalf("Should have ran for about 500ms at double speed: %s", double)
+	}
+
+	govfx.SetTimeScale(0.5)
+	scaled, _ := playFor(1)
+	govfx.SetTimeScale(1)
+
+	if scaled != half {
+		t.Fatalf("Should have slowed down with the global time scale: %s and %s", scaled, half)
+	}
+
+	backward, bev := playFor(-1)
+	if backward == 0 || len(bev.progress) == 0 {
+		t.Fatal("Should have played the timeline backward to its end")
+	}
+
+	for ind := 1; ind < len(bev.progress); ind++ {
+		if bev.progress[ind] > bev.progress[ind-1] {
+			t.Fatalf("Should have received decreasing progress when backward: %.4f after %.4f", bev.progress[ind], bev.progress[ind-1])
+		}
+	}
+
+	if first := bev.progress[0]; first < 0.9 {
+		t.Fatalf("Should have started from the end when backward: %.4f", first)
+	}
+}
+
+// widthOf returns the width written into the style of the element.
+func widthOf(elem govfx.Elemental) int {
+	return govfx.ParseInt(elem.GetAttribute("style"))
+}
+
+// TestTimelineReverse validates the reversed run of a timeline together with
+// loops, pause and seek.
+func TestTimelineReverse(t *testing.T) {
+	newDocument(1)
+
+	var ends int
+
+	clock := govfx.NewManualClock(time.Unix(0, 0))
+	items := govfx.QuerySelectorAll(".item")
+
+	timeline, err := govfx.Animate(govfx.Stat{
+		Duration: 1 * time.Second,
+		Loop:     2,
+		Reverse:  true,
+		Clock:    clock,
+		End:      govfx.NewListener(func(float64) { ends++ }),
+	}, govfx.Values{
+		{"value": 500, "animate": "width", "easing": "linear"},
+	}, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timeline.Start()
+
+	// Play the forward run and move into the reversed run.
+	clock.Frames(70, time.Second/60)
+
+	first := widthOf(items[0])
+	clock.Frames(40, time.Second/60)
+
+	last := widthOf(items[0])
+	if last >= first {
+		t.Fatalf("Should have decreased the width in the reversed run: %d after %d", last, first)
+	}
+
+	// Pausing must freeze the reversed run.
+	timeline.Pause()
+	clock.Frames(30, time.Second/60)
+
+	if width := widthOf(items[0]); width != last {
+		t.Fatalf("Should have kept the width while paused: %d and %d", width, last)
+	}
+
+	timeline.Resume()
+
+	// Seeking into the reversed run mirrors the forward state.
+	timeline.Seek(1500 * time.Millisecond)
+	mirrored := items[0].GetAttribute("style")
+
+	timeline.Seek(500 * time.Millisecond)
+	if style := items[0].GetAttribute("style"); style != mirrored {
+		t.Fatalf("Should have mirrored the forward state: %q and %q", style, mirrored)
+	}
+
+	clock.Frames(600, time.Second/60)
+
+	if ends != 1 {
+		t.Fatalf("Should have ended once after all loops: %d", ends)
+	}
+
+	if width := widthOf(items[0]); width != 100 {
+		t.Fatalf("Should have reversed back to the initial width: %d", width)
+	}
+}
+
+// TestTimelineSeekFromListener validates that a timeline can be seeked and
+// resumed from within its own listeners.
+func TestTimelineSeekFromListener(t *testing.T) {
+	newDocument(1)
+
+	var seeked bool
+	var ends int
+
+	clock := govfx.NewManualClock(time.Unix(0, 0))
+	items := govfx.QuerySelectorAll(".item")
+
+	var timeline *govfx.Timeline
+	var err error
+
+	timeline, err = govfx.Animate(govfx.Stat{
+		Duration: 1 * time.Second,
+		Clock:    clock,
+		End:      govfx.NewListener(func(float64) { ends++ }),
+		Progress: govfx.NewListener(func(progress float64) {
+			if seeked || progress < 0.5 {
+				return
+			}
+
+			seeked = true
+			timeline.Seek(0)
+			timeline.Resume()
+		}),
+	}, govfx.Values{
+		{"value": 500, "animate": "width", "easing": "linear"},
+	}, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		timeline.Start()
+		clock.Frames(40, time.Second/60)
+
+		if width := widthOf(items[0]); width > 200 {
+			t.Errorf("Should have seeked back to the start from the listener: %d", width)
+		}
+
+		clock.Frames(120, time.Second/60)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(3 * time.Second):
+		t.Fatal("Should have seeked from the listener without deadlocking")
+	}
+
+	if !seeked || ends != 1 {
+		t.Fatalf("Should have seeked once and ended once: %t and %d ends", seeked, ends)
+	}
+
+	if width := widthOf(items[0]); width != 500 {
+		t.Fatalf("Should have ended at the end state: %d", width)
+	}
+}
+
+// TestTimelineBackwardEnd validates that a timeline played backward, from its
+// rate or from the global time scale, ends at its exact start state.
+func TestTimelineBackwardEnd(t *testing.T) {
+	play := func(rate float64, scale float64) (int, int) {
+		newDocument(1)
+
+		var ends int
+
+		clock := govfx.NewManualClock(time.Unix(0, 0))
+		items := govfx.QuerySelectorAll(".item")
+
+		timeline, err := govfx.Animate(govfx.Stat{
+			Duration: 1 * time.Second,
+			Clock:    clock,
+			End:      govfx.NewListener(func(float64) { ends++ }),
+		}, govfx.Values{
+			{"value": 500, "animate": "width", "easing": "linear"},
+		}, items)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		govfx.SetTimeScale(scale)
+		defer govfx.SetTimeScale(1)
+
+		timeline.SetRate(rate)
+		timeline.Start()
+
+		for i := 0; i < 200 && clock.Loops() > 0; i++ {
+			clock.Step(time.Second / 60)
+		}
+
+		return widthOf(items[0]), ends
+	}
+
+	if width, ends := play(-1, 1); width != 100 || ends != 1 {
+		t.Fatalf("Should have ended once at the start width with a negative rate: %d and %d ends", width, ends)
+	}
+
+	if width, ends := play(1, -1); width != 100 || ends != 1 {
+		t.Fatalf("Should have ended once at the start width with a negative time scale: %d and %d ends", width, ends)
+	}
+
+	if width, ends := play(-1, -1); width != 500 || ends != 1 {
+		t.Fatalf("Should have played forward with a negative rate and time scale: %d and %d ends", width, ends)
+	}
+}
+
+// TestTimelineLoopState validates that every loop of a timeline is computed
+// from its sequences rather than replayed from the first loop.
+func TestTimelineLoopState(t *testing.T) {
+	newDocument(1)
+
+	clock := govfx.NewManualClock(time.Unix(0, 0))
+	items := govfx.QuerySelectorAll(".item")
+
+	timeline, err := govfx.Animate(govfx.Stat{
+		Duration: 1 * time.Second,
+		Loop:     2,
+		Clock:    clock,
+	}, govfx.Values{
+		{"value": 500, "animate": "width", "easing": "linear"},
+	}, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timeline.Start()
+
+	for i := 0; i < 120 && widthOf(items[0]) != 500; i++ {
+		clock.Step(time.Second / 60)
+	}
+
+	// Move into the second loop, then play it at half speed.
+	clock.Frames(3, time.Second/60)
+	timeline.SetRate(0.5)
+	clock.Frames(30, time.Second/60)
+
+	if width := govfx.ParseFloat(items[0].GetAttribute("style")); width < 170 || width > 240 {
+		t.Fatalf("Should have computed the second loop at half speed: %.2f", width)
+	}
+}
@@ -0,0 +1,350 @@
+package govfx
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//==============================================================================
+
+// contains the order in which the transform functions of the different
+// transform sequences of a element are composed, where functions with a lower
+// order are applied first.
+const (
+	PerspectiveOrder = iota * 10
+	TranslateOrder
+	RotateOrder
+	SkewOrder
+	ScaleOrder
+)
+
+// Transformer defines a sequence which provides a single function of the css
+// transform property (eg translateX(20px)). The functions of all transformers
+// of a element are composed into one transform declaration ordered by their
+// TransformOrder, instead of each writing its own transform declaration.
+type Transformer interface {
+	TransformOrder() int
+	Transform(io.Writer)
+}
+
+// asTransformer returns the provided sequence as a Transformer if it is one,
+// looking through keyframes to the sequence of their active stop.
+func asTransformer(seq Sequence) (Transformer, bool) {
+	if kf, ok := seq.(*Keyframes); ok {
+		seq = kf.seqs[kf.active]
+	}
+
+	tf, ok := seq.(Transformer)
+	return tf, ok
+}
+
+// transformDeclaration returns the composed transform declaration of the
+// provided transformers, applied on top of the base transform the element had
+// before its animation. The functions of the base transform not animated by
+// the transformers are kept, each placed in its order among the animated
+// functions.
+func transformDeclaration(base *Decomposition, tfs []Transformer) Declaration {
+	var parts []transformPart
+
+	animated := make(map[string]bool)
+
+	for _, tf := range tfs {
+		var buf bytes.Buffer
+		tf.Transform(&buf)
+
+		part := transformPart{order: tf.TransformOrder(), text: buf.String()}
+
+		if fns := ParseTransform(part.text); len(fns) != 0 {
+			animated[fns[0].Name] = true
+			part.slot = transformSlots[fns[0].Name]
+		}
+
+		parts = append(parts, part)
+	}
+
+	if base != nil {
+		parts = append(parts, baseTransformParts(*base, animated)...)
+	}
+
+	sort.SliceStable(parts, func(i, j int) bool {
+		if parts[i].order != parts[j].order {
+			return parts[i].order < parts[j].order
+		}
+
+		return parts[i].slot < parts[j].slot
+	})
+
+	items := make([]string, len(parts))
+	for ind, part := range parts {
+		items[ind] = part.text
+	}
+
+	return Declaration{Property: "transform", Value: strings.Join(items, " ")}
+}
+
+// transformPart defines a single function of a composed transform, placed by
+// its order and by its slot among the functions of the same order (eg
+// rotateX before rotateY before rotate).
+type transformPart struct {
+	order int
+	slot  int
+	text  string
+}
+
+// transformSlots defines the slot of the transform functions among the
+// functions of the same order, following the axes x, y then z.
+var transformSlots = map[string]int{
+	"translateY": 1,
+	"translateZ": 2,
+	"rotateY":    1,
+	"rotate":     2,
+	"rotateZ":    2,
+	"skewY":      1,
+	"scaleY":     1,
+	"scaleZ":     2,
+}
+
+// baseTransformParts returns the functions of the decomposed base transform
+// which are not animated, skipping those without effect.
+func baseTransformParts(dc Decomposition, animated map[string]bool) []transformPart {
+	var parts []transformPart
+
+	add := func(order int, name string, value float64, identity float64, unit string) {
+		if animated[name] || math.Abs(value-identity) < 1e-6 {
+			return
+		}
+
+		// rotate and rotateZ describe the same function.
+		if name == "rotate" && animated["rotateZ"] {
+			return
+		}
+
+		parts = append(parts, transformPart{
+			order: order,
+			slot:  transformSlots[name],
+			text:  name + "(" + formatNumber(value) + unit + ")",
+		})
+	}
+
+	if perspective, _ := dc.Component("perspective"); perspective != 0 {
+		add(PerspectiveOrder, "perspective", perspective, 0, "px")
+	}
+
+	add(TranslateOrder, "translateX", dc.Translate[0], 0, "px")
+	add(TranslateOrder, "translateY", dc.Translate[1], 0, "px")
+	add(TranslateOrder, "translateZ", dc.Translate[2], 0, "px")
+
+	x, y, z := dc.Rotation()
+	add(RotateOrder, "rotateX", x, 0, "deg")
+	add(RotateOrder, "rotateY", y, 0, "deg")
+	add(RotateOrder, "rotate", z, 0, "deg")
+
+	// The skews along the z axis have no transform function, hence all skews
+	// are written as their matrix when the base has any of them.
+	if dc.Skew[1] != 0 || dc.Skew[2] != 0 {
+		if animated["skewX"] {
+			dc.Skew[0] = 0
+		}
+
+		parts = append(parts, transformPart{order: SkewOrder, text: dc.skew().String()})
+	} else {
+		skew, _ := dc.Component("skewX")
+		add(SkewOrder, "skewX", skew, 0, "deg")
+	}
+
+	add(ScaleOrder, "scaleX", dc.Scale[0], 1, "")
+	add(ScaleOrder, "scaleY", dc.Scale[1], 1, "")
+	add(ScaleOrder, "scaleZ", dc.Scale[2], 1, "")
+
+	return parts
+}
+
+// baseTransform returns the decomposition of the current transform of the
+// element, else nil if it has none or it can not be decomposed.
+func baseTransform(elem Elemental) *Decomposition {
+	value, _, ok := elem.Read("transform", "")
+	if !ok {
+		return nil
+	}
+
+	value = strings.TrimSpace(value)
+	if value == "" || value == "none" {
+		return nil
+	}
+
+	mx, err := ParseMatrixIn(value, TransformContextOf(elem))
+	if err != nil {
+		return nil
+	}
+
+	dc, ok := mx.Decompose()
+	if !ok {
+		return nil
+	}
+
+	return &dc
+}
+
+//==============================================================================
+
+// TransformContext defines the contexts the lengths of the translate
+// functions of a transform resolve against, where percentages are taken of
+// the width of the element itself for the x axis and of its height for the y
+// axis.
+type TransformContext struct {
+	X LengthContext
+	Y LengthContext
+}
+
+// TransformContextOf returns the TransformContext of the element using the
+// current backend.
+func TransformContextOf(elem DOMElement) TransformContext {
+	b := GetBackend()
+
+	ctx := ContextOf(elem, "transform")
+	ctx.Percent = lengthOf(b, elem, "width")
+
+	tc := TransformContext{X: ctx, Y: ctx}
+	tc.Y.Percent = lengthOf(b, elem, "height")
+
+	return tc
+}
+
+//==============================================================================
+
+// TransformFunction defines a single function of a css transform value (eg
+// rotate(45deg)) with its list of arguments.
+type TransformFunction struct {
+	Name string
+	Args []string
+}
+
+// Arg returns the numeric value of the argument at the giving index, else
+// returns false if the function has no such argument.
+func (t TransformFunction) Arg(index int) (float64, bool) {
+	if index >= len(t.Args) {
+		return 0, false
+	}
+
+	return ParseNumber(t.Args[index]), true
+}
+
+// Resolve returns the function with the lengths of its translate arguments
+// (eg 50% or 2em) resolved into pixels within the context, leaving the
+// arguments of other functions as they are.
+func (t TransformFunction) Resolve(ctx TransformContext) TransformFunction {
+	var axes []LengthContext
+
+	switch t.Name {
+	case "translate", "translate3d":
+		axes = []LengthContext{ctx.X, ctx.Y, ctx.X}
+	case "translateX", "translateZ", "perspective":
+		axes = []LengthContext{ctx.X}
+	case "translateY":
+		axes = []LengthContext{ctx.Y}
+	default:
+		return t
+	}
+
+	resolved := TransformFunction{Name: t.Name, Args: make([]string, len(t.Args))}
+
+	for ind, arg := range t.Args {
+		resolved.Args[ind] = arg
+
+		if ind >= len(axes) {
+			continue
+		}
+
+		// The z axis has no size a percentage could be taken of.
+		lctx := axes[ind]
+		if ind == 2 || t.Name == "translateZ" {
+			lctx.Percent = 0
+		}
+
+		if length, err := ParseLength(arg); err == nil {
+			resolved.Args[ind] = formatNumber(length.Pixels(lctx)) + "px"
+		}
+	}
+
+	return resolved
+}
+
+// ParseTransform parses a css transform value (eg "translateX(20px) rotate(45deg)")
+// into its list of transform functions in the order they appear.
+func ParseTransform(value string) []TransformFunction {
+	var fns []TransformFunction
+
+	for {
+		start := strings.Index(value, "(")
+		end := strings.Index(value, ")")
+
+		if start < 0 || end < start {
+			return fns
+		}
+
+		fn := TransformFunction{
+			Name: strings.TrimSpace(value[:start]),
+		}
+
+		args := strings.FieldsFunc(value[start+1:end], func(r rune) bool {
+			return r == ',' || r == ' '
+		})
+
+		for _, arg := range args {
+			fn.Args = append(fn.Args, strings.TrimSpace(arg))
+		}
+
+		fns = append(fns, fn)
+		value = value[end+1:]
+	}
+}
+
+// TransformArg returns the numeric value of the argument at the giving index of
+// the first function of the transform value with the provided name, else
+// returns false if no such function exists.
+func TransformArg(value string, name string, index int) (float64, bool) {
+	for _, fn := range ParseTransform(value) {
+		if fn.Name != name {
+			continue
+		}
+
+		return fn.Arg(index)
+	}
+
+	return 0, false
+}
+
+// TransformArgIn returns the numeric value of the argument at the giving index
+// of the first function of the transform value with the provided name, with
+// its translate lengths resolved into pixels within the context, else returns
+// false if no such function exists.
+func TransformArgIn(value string, name string, index int, ctx TransformContext) (float64, bool) {
+	for _, fn := range ParseTransform(value) {
+		if fn.Name != name {
+			continue
+		}
+
+		return fn.Resolve(ctx).Arg(index)
+	}
+
+	return 0, false
+}
+
+//==============================================================================
+
+// numberMatch defines a matcher for the leading number of a css value.
+var numberMatch = regexp.MustCompile("^[-+]?(\\d+\\.?\\d*|\\.\\d+)([eE][-+]?\\d+)?")
+
+// ParseNumber parses the leading number of a css value (eg -20.5px) keeping its
+// sign, else returns 0 if it has none.
+func ParseNumber(value string) float64 {
+	num, _ := strconv.ParseFloat(numberMatch.FindString(strings.TrimSpace(value)), 64)
+	return num
+}
+
+//==============================================================================
@@ -0,0 +1,126 @@
+package govfx_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influx6/govfx"
+)
+
+// TestParseTransform validates the parsing of css transform values.
+func TestParseTransform(t *testing.T) {
+	fns := govfx.ParseTransform("translate(-20px, 10.5px) rotate(45deg) scale(2)")
+	if len(fns) != 3 {
+		t.Fatalf("Should have parsed 3 functions but got %d", len(fns))
+	}
+
+	if x, _ := fns[0].Arg(0); x != -20 {
+		t.Fatalf("Should have parsed a negative translation but got %.2f", x)
+	}
+
+	if y, ok := govfx.TransformArg("translate(-20px, 10.5px)", "translate", 1); !ok || y != 10.5 {
+		t.Fatalf("Should have read the second translate argument but got %.2f", y)
+	}
+
+	if _, ok := govfx.TransformArg("scale(2)", "scale", 1); ok {
+		t.Fatal("Should have found no second scale argument")
+	}
+}
+
+// TestTransformCompose validates that the transform sequences of a element are
+// composed into a single ordered transform declaration, keeping the functions
+// of its transform which are not animated.
+func TestTransformCompose(t *testing.T) {
+	doc := newDocument(1)
+	doc.AddRule(".item", "transform: translateX(-50px) scale(2)")
+
+	items := govfx.QuerySelectorAll(".item")
+	timeline, err := govfx.Animate(govfx.Stat{
+		Duration: 1 * time.Second,
+		Clock:    govfx.NewManualClock(time.Unix(0, 0)),
+	}, govfx.Values{
+		{"value": 1, "animate": "scale-x", "easing": "linear"},
+		{"value": 90, "animate": "rotate", "easing": "linear"},
+		{"value": 50, "animate": "translate-x", "easing": "linear"},
+		{"value": 100, "animate": "test-offset", "easing": "linear"},
+	}, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timeline.SeekProgress(0.5)
+
+	expected := "left: 50px; transform: translateX(0px) rotate(45deg) scaleX(1.5) scaleY(2)"
+	if style := items[0].GetAttribute("style"); style != expected {
+		t.Fatalf("Should have composed the transform:\n%q\n%q", style, expected)
+	}
+
+	timeline.SeekProgress(1)
+
+	expected = "left: 100px; transform: translateX(50px) rotate(90deg) scaleX(1) scaleY(2)"
+	if style := items[0].GetAttribute("style"); style != expected {
+		t.Fatalf("Should have composed the final transform:\n%q\n%q", style, expected)
+	}
+}
+
+// TestTransformBase validates that the animated transform functions are
+// composed on top of the transform of the element, resolving its translate
+// lengths against the element.
+func TestTransformBase(t *testing.T) {
+	newDocument(1)
+
+	items := govfx.QuerySelectorAll(".item")
+	items[0].SetAttribute("style", "transform: translate(50%, 2em) rotate(30deg)")
+
+	timeline, err := govfx.Animate(govfx.Stat{
+		Duration: 1 * time.Second,
+		Clock:    govfx.NewManualClock(time.Unix(0, 0)),
+	}, govfx.Values{
+		{"value": 150, "animate": "translate-x", "easing": "linear"},
+	}, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timeline.SeekProgress(0.5)
+
+	expected := "transform: translateX(100px) translateY(32px) rotate(30deg)"
+	if style := items[0].GetAttribute("style"); style != expected {
+		t.Fatalf("Should have kept the static transform functions:\n%q\n%q", style, expected)
+	}
+}
+
+// TestTransformBaseSkew validates that the skews of the base transform are
+// kept, where those along the z axis are written as their matrix.
+func TestTransformBaseSkew(t *testing.T) {
+	expected := []struct {
+		base  string
+		style string
+	}{
+		{"skewX(20deg)", "transform: translateX(100px) skewX(20deg)"},
+		{"matrix3d(1, 0, 0, 0, 0, 1, 0, 0, 0, 0.5, 1, 0, 0, 0, 0, 1)", "transform: translateX(100px) matrix3d(1, 0, 0, 0, 0, 1, 0, 0, 0, 0.5, 1, 0, 0, 0, 0, 1)"},
+	}
+
+	for _, item := range expected {
+		newDocument(1)
+
+		items := govfx.QuerySelectorAll(".item")
+		items[0].SetAttribute("style", "transform: "+item.base)
+
+		timeline, err := govfx.Animate(govfx.Stat{
+			Duration: 1 * time.Second,
+			Clock:    govfx.NewManualClock(time.Unix(0, 0)),
+		}, govfx.Values{
+			{"value": 200, "animate": "translate-x", "easing": "linear"},
+		}, items)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		timeline.SeekProgress(0.5)
+
+		if style := items[0].GetAttribute("style"); style != item.style {
+			t.Fatalf("Should have kept the skew of %q:\n%q\n%q", item.base, style, item.style)
+		}
+	}
+}
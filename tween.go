@@ -0,0 +1,80 @@
+package govfx
+
+//==============================================================================
+
+// Lerp returns the linear interpolation between the from and to values by the
+// provided amount, where 0 returns from and 1 returns to.
+func Lerp(from float64, to float64, amount float64) float64 {
+	return from + (to-from)*amount
+}
+
+//==============================================================================
+
+// Tween defines the shared interpolation core of the sequences, which records
+// the values to tween from and to when a sequence is initialized and
+// evaluates its value purely from the normalized progress of the timeline,
+// hence a tween always ends exactly on its target regardless of the frame
+// timing. It also keeps the value of the previous fixed update, allowing the
+// rendered value to be blended between the two fixed updates.
+type Tween struct {
+	From  float64
+	To    float64
+	Easer Easing
+
+	previous float64
+	current  float64
+	value    float64
+}
+
+// NewTween returns a new Tween from and to the provided values using the
+// easing provider, where a nil easing uses the default easing.
+func NewTween(from float64, to float64, easer Easing) Tween {
+	if easer == nil {
+		easer = GetEasing(DefaultEasing)
+	}
+
+	return Tween{
+		From:     from,
+		To:       to,
+		Easer:    easer,
+		previous: from,
+		current:  from,
+		value:    from,
+	}
+}
+
+// Update sets the value of the tween for the progress between 0 and 1, keeping
+// the value of the previous update for blending. Once the progress reaches its
+// end, the tween settles on its final value.
+func (t *Tween) Update(progress float64) {
+	t.previous = t.current
+	t.current = Lerp(t.From, t.To, t.Easer.Ease(progress))
+	t.value = t.current
+
+	if progress >= 1 {
+		t.previous = t.current
+	}
+}
+
+// Set sets the value of the tween for the progress between 0 and 1 as both its
+// previous and current update, hence there is nothing to blend from, as used
+// when seeking to a moment unrelated to the previous update.
+func (t *Tween) Set(progress float64) {
+	t.current = Lerp(t.From, t.To, t.Easer.Ease(progress))
+	t.previous = t.current
+	t.value = t.current
+}
+
+// Blend sets the value of the tween to the interpolation between the previous
+// and current fixed updates by the provided amount between 0 and 1, which is
+// the fraction of a fixed step remaining when rendering.
+func (t *Tween) Blend(interpolate float64) {
+	t.value = Lerp(t.previous, t.current, interpolate)
+}
+
+// Value returns the current value of the tween.
+func (t Tween) Value() float64 {
+	return t.value
+}
+
+//==============================================================================
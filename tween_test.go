@@ -0,0 +1,126 @@
+package govfx_test
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/influx6/govfx"
+)
+
+// TestTween validates the evaluation and blending of tweens.
+func TestTween(t *testing.T) {
+	tween := govfx.NewTween(100, 300, govfx.GetEasing("linear"))
+
+	if tween.Value() != 100 {
+		t.Fatalf("Should have started from the initial value but got %.2f", tween.Value())
+	}
+
+	tween.Update(0.25)
+	tween.Update(0.5)
+
+	if tween.Value() != 200 {
+		t.Fatalf("Should have evaluated the value from the progress but got %.2f", tween.Value())
+	}
+
+	tween.Blend(0.5)
+
+	if tween.Value() != 175 {
+		t.Fatalf("Should have blended between the last updates but got %.2f", tween.Value())
+	}
+
+	tween.Update(1)
+	tween.Blend(0.5)
+
+	if tween.Value() != 300 {
+		t.Fatalf("Should have settled on the target but got %.2f", tween.Value())
+	}
+
+	tween.Update(0.25)
+	tween.Set(0.75)
+	tween.Blend(0)
+
+	if tween.Value() != 250 {
+		t.Fatalf("Should have set the value with nothing to blend from but got %.2f", tween.Value())
+	}
+}
+
+// counter defines a sequence counting its updates.
+type counter struct {
+	updates int
+}
+
+func (c *counter) Init(elem govfx.Elemental) error {
+	return nil
+}
+
+func (c *counter) Update(delta float64, timeline float64) {
+	c.updates++
+}
+
+func (c *counter) CSS(w io.Writer) {}
+
+// TestSeekUpdates validates that seeking updates sequences which are not
+// Seekable only once.
+func TestSeekUpdates(t *testing.T) {
+	newDocument(1)
+
+	var seq counter
+
+	items := govfx.QuerySelectorAll(".item")
+	items[0].Add(&seq)
+
+	frame, err := govfx.NewSeqBev(items, govfx.Stat{Duration: 1 * time.Second}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	frame.Seek(0.01, 0.5, 1)
+
+	if seq.updates != 1 {
+		t.Fatalf("Should have updated the sequence once but got %d updates", seq.updates)
+	}
+}
+
+// TestTweenFrameTiming validates that animations end on their target with
+// irregular frame timing.
+func TestTweenFrameTiming(t *testing.T) {
+	frames := [][]time.Duration{
+		{time.Second / 60},
+		{7 * time.Millisecond, 23 * time.Millisecond, 41 * time.Millisecond},
+		{130 * time.Millisecond, 3 * time.Millisecond},
+	}
+
+	for _, steps := range frames {
+		doc := newDocument(1)
+		doc.Body().SetAttribute("style", "width: 400px")
+
+		var ended bool
+
+		clock := govfx.NewManualClock(time.Unix(0, 0))
+		items := govfx.QuerySelectorAll(".item")
+
+		timeline, err := govfx.Animate(govfx.Stat{
+			Duration: 1 * time.Second,
+			Clock:    clock,
+			End:      govfx.NewListener(func(float64) { ended = true }),
+		}, govfx.Values{
+			{"value": 300, "animate": "left", "easing": "ease-in"},
+			{"value": "50%", "animate": "width", "easing": "linear"},
+		}, items)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		timeline.Start()
+
+		for i := 0; i < 1000 && !ended; i++ {
+			clock.Step(steps[i%len(steps)])
+		}
+
+		expected := "left: 300px; width: 50%"
+		if style := items[0].GetAttribute("style"); style != expected {
+			t.Fatalf("Should have ended on the target with frames of %v:\n%q\n%q", steps, style, expected)
+		}
+	}
+}
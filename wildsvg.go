@@ -1,14 +1,22 @@
 package govfx
 
 import (
+	"errors"
+	"sort"
 	"time"
-
-	"honnef.co/go/js/dom"
 )
 
+// ErrInvalidSVGBox defines the error returned when the width or height of a
+// SVGConfig is not larger than 0, as the path is scaled by them.
+var ErrInvalidSVGBox = errors.New("Invalid SVG Box")
+
+// DefaultSamplingSize defines the number of samples taken from the path of a
+// SVGPathEaser when its config provides none.
+const DefaultSamplingSize = 300
+
 // CurvePoint defines a area along a svg path for a specific progress and x value.
 type CurvePoint struct {
-	Length         int
+	Length         float64
 	X              float64
 	Y              float64
 	Xd             float64
@@ -18,17 +26,17 @@ type CurvePoint struct {
 
 // SVGPathEaser defines a easing provider using SVG Paths.
 type SVGPathEaser struct {
-	pathlength int
-	conf       SVGConfig
-	samples    []CurvePoint
-	svgElement dom.Element
-	start      time.Time
-	end        time.Time
-	delta      time.Duration
+	path    *Path
+	conf    SVGConfig
+	samples []CurvePoint
+	start   time.Time
+	end     time.Time
+	delta   time.Duration
 }
 
-// SVGConfig defines a configuration object for the SVGPathEaser.
-// WARNING: Requires to be loaded within the dom.
+// SVGConfig defines a configuration object for the SVGPathEaser, where the
+// path is drawn within a box of the width and height with its y axis pointing
+// down, as in svg.
 type SVGConfig struct {
 	SVGPath      string
 	Width        int
@@ -36,52 +44,76 @@ type SVGConfig struct {
 	SamplingSize int
 }
 
-// NewSVGPathEaser returns a new instance of the SVGPathEaser.
-func NewSVGPathEaser(conf SVGConfig) *SVGPathEaser {
+// NewSVGPathEaser returns a new instance of the SVGPathEaser, else an error
+// if the path data is invalid or the box of the path is empty.
+func NewSVGPathEaser(conf SVGConfig) (*SVGPathEaser, error) {
+	if conf.Width <= 0 || conf.Height <= 0 {
+		return nil, ErrInvalidSVGBox
+	}
+
+	path, err := ParsePath(conf.SVGPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.SamplingSize < 2 {
+		conf.SamplingSize = DefaultSamplingSize
+	}
+
 	var svg SVGPathEaser
 
 	svg.conf = conf
-	svg.svgElement = dom.WrapElement(Document().Underlying().Call("createElementNS", "http://www.w3.org/2000/svg", "path"))
-	svg.svgElement.SetAttribute("d", conf.SVGPath)
-
-	svg.pathlength = svg.svgElement.Underlying().Call("getTotalLength", nil).Int()
+	svg.path = path
 
 	svg.generateSampling()
-	return &svg
+	return &svg, nil
 }
 
-// Sample for the giving t value between 0..1, we return a CurvePoint that is
-// either at that range or within that specified range. Also returns a bool
-// whether it was a success or failure.
+// Sample for the giving t value between 0..1, we return the last CurvePoint
+// at or before that value. Also returns a bool whether it was a success or
+// failure, which fails for values outside of the samples.
 func (svg *SVGPathEaser) Sample(t float64) (CurvePoint, bool) {
-	var item CurvePoint
-	var found bool
-
-	for ind, elem := range svg.samples {
-		nextInd := ind + 1
-
-		// Clamp the nextInd to ensure it never overflows.
-		if nextInd >= svg.conf.SamplingSize {
-			nextInd = svg.conf.SamplingSize - 1
-		}
-
-		next := svg.samples[nextInd]
-		if elem.Xd == t || (elem.Xd < t && next.Xd > t) {
-			item = elem
-			found = true
-			break
-		}
+	index := svg.search(t)
+
+	if index < len(svg.samples) && svg.samples[index].Xd == t {
+		return svg.samples[index], true
 	}
 
-	return item, found
+	if index == 0 || index == len(svg.samples) {
+		return CurvePoint{}, false
+	}
+
+	return svg.samples[index-1], true
 }
 
-// Ease returns the giving value of t(time) within the giving svg sample.
+// Ease returns the giving value of t(time) within the giving svg sample,
+// interpolated between the samples around it and clamped to the first and
+// last samples.
 func (svg *SVGPathEaser) Ease(t float64) float64 {
-	if item, ok := svg.Sample(t); ok {
-		return item.Yd
+	index := svg.search(t)
+
+	if index == 0 {
+		return svg.samples[0].Yd
+	}
+
+	if index == len(svg.samples) {
+		return svg.samples[index-1].Yd
 	}
-	return 0
+
+	from, to := svg.samples[index-1], svg.samples[index]
+	if to.Xd == from.Xd {
+		return to.Yd
+	}
+
+	return Lerp(from.Yd, to.Yd, (t-from.Xd)/(to.Xd-from.Xd))
+}
+
+// search returns the index of the first sample at or after the provided x
+// value, using a binary search as the x values of a easing path increase.
+func (svg *SVGPathEaser) search(t float64) int {
+	return sort.Search(len(svg.samples), func(ind int) bool {
+		return svg.samples[ind].Xd >= t
+	})
 }
 
 // Stats returns the giving time details taken to generate the samples.
@@ -93,27 +125,28 @@ func (svg *SVGPathEaser) Stats() (delta time.Duration, start time.Time, end time
 }
 
 // generateSampling the samplings based on the sampling size from the
-// svg data.
+// svg data, spaced evenly along the length of the path.
 func (svg *SVGPathEaser) generateSampling() {
-	samplePct := float64(1 / svg.conf.SamplingSize)
+	samplePct := 1 / float64(svg.conf.SamplingSize-1)
+	total := svg.path.TotalLength()
 
 	svg.start = time.Now()
 
 	for i := 0; i < svg.conf.SamplingSize; i++ {
 		step := float64(i) * samplePct
-		fsm := step * float64(svg.pathlength)
+		fsm := step * total
 
 		var point CurvePoint
 
 		point.SampleProgress = step
-		point.Length = int(fsm)
+		point.Length = fsm
 
-		fsmObj := svg.svgElement.Underlying().Call("getPointAtLength", fsm)
+		pt := svg.path.PointAtLength(fsm)
 
-		point.X = fsmObj.Get("x").Float()
+		point.X = pt.X
 		point.Xd = point.X / float64(svg.conf.Width)
 
-		point.Y = fsmObj.Get("y").Float()
+		point.Y = pt.Y
 		point.Yd = 1 - (point.Y / float64(svg.conf.Height))
 
 		svg.samples = append(svg.samples, point)